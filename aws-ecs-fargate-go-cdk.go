@@ -9,6 +9,7 @@
 package main
 
 import (
+	"aws-ecs-fargate-go-cdk/internal/config"
 	"aws-ecs-fargate-go-cdk/internal/stacks"
 	"fmt"
 	"os"
@@ -32,12 +33,66 @@ func main() {
 
 	fmt.Printf("🚀 Building infrastructure for environment: %s\n", environment)
 
+	// セキュリティグループ定義ファイル（任意）をコンテキストから取得
+	var securityMatrix *config.SecurityMatrixConfig
+	if matrixFile, ok := app.Node().TryGetContext(jsii.String("securityMatrixFile")).(string); ok && matrixFile != "" {
+		matrix, err := config.LoadSecurityMatrixConfig(matrixFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "❌ Error: %v\n", err)
+			os.Exit(1)
+		}
+		securityMatrix = matrix
+	}
+
+	// Egress制限（コンテキストで指定、全Stackに同じ設定を渡す）
+	// セキュリティグループ定義ファイルを指定した場合は定義のrestrictEgressを使用
+	restrictEgress := false
+	switch flag := app.Node().TryGetContext(jsii.String("restrictEgress")).(type) {
+	case bool:
+		restrictEgress = flag
+	case string:
+		restrictEgress = flag == "true"
+	}
+	if securityMatrix != nil {
+		if restrictEgress != securityMatrix.RestrictEgress {
+			fmt.Printf("⚠️  restrictEgress context is ignored: using restrictEgress=%t from the security matrix file\n", securityMatrix.RestrictEgress)
+		}
+		restrictEgress = securityMatrix.RestrictEgress
+	}
+
+	// 0. KeyStack（データ分類別のカスタマー管理キー、CMKを使用する環境のみ）
+	// キーはリージョン単位のため、DR・バックアップのコピー先リージョンにも作成
+	keyStacks := map[string]awscdk.Stack{}
+	keyStackFor := func(id string, stackEnv *awscdk.Environment) awscdk.Stack {
+		if len(config.GetEncryptionConfig(environment).DataClasses) == 0 {
+			return nil
+		}
+		if keyStack, ok := keyStacks[*stackEnv.Region]; ok {
+			return keyStack
+		}
+		keyStacks[*stackEnv.Region] = stacks.NewKeyStack(app, id, &stacks.KeyStackProps{
+			StackProps: awscdk.StackProps{
+				Env: stackEnv,
+			},
+			Environment: environment,
+		})
+		return keyStacks[*stackEnv.Region]
+	}
+	dependOnKeys := func(stack awscdk.Stack, keyStack awscdk.Stack) {
+		if keyStack != nil {
+			stack.AddDependency(keyStack, nil)
+		}
+	}
+	keyStack := keyStackFor("KeyStack", env())
+
 	// 1. NetworkStackを作成
 	networkStack := stacks.NewNetworkStack(app, "NetworkStack", &stacks.NetworkStackProps{
 		StackProps: awscdk.StackProps{
 			Env: env(),
 		},
-		Environment: environment,
+		Environment:    environment,
+		SecurityMatrix: securityMatrix,
+		RestrictEgress: restrictEgress,
 		// VpcCidrは環境設定から自動取得される
 	})
 
@@ -46,9 +101,11 @@ func main() {
 		StackProps: awscdk.StackProps{
 			Env: env(),
 		},
-		Environment: environment,
-		VpcId:       "vpc-from-network-stack", // Cross-stack参照で自動解決
-		TestEnvFlag: false,                    // 実際のデプロイ環境
+		Environment:    environment,
+		VpcId:          "vpc-from-network-stack", // Cross-stack参照で自動解決
+		TestEnvFlag:    false,                    // 実際のデプロイ環境
+		RestrictEgress: restrictEgress,
+		SecurityMatrix: securityMatrix,
 	})
 
 	// 3. 将来のApplicationStackをここに追加
@@ -61,11 +118,81 @@ func main() {
 		DatabaseEndpoint: *awscdk.Fn_ImportValue(jsii.String("service-" + environment + "-Aurora-Endpoint")),
 		RedisEndpoint:    *awscdk.Fn_ImportValue(jsii.String("service-" + environment + "-Redis-Endpoint")),
 		TestEnvFlag:      false,
+		RestrictEgress:   restrictEgress,
+		SecurityMatrix:   securityMatrix,
 	})
 
 	// Stack間の依存関係を設定
 	storageStack.AddDependency(networkStack, nil)
 	applicationStack.AddDependency(storageStack, nil)
+	dependOnKeys(storageStack, keyStack)
+	dependOnKeys(applicationStack, keyStack)
+
+	// 4. Aurora Global Database（DRリージョンにNetworkStackとセカンダリクラスターを作成）
+	if dbGlobal := config.GetDatabaseConfig(environment).Global; dbGlobal.Enabled {
+		drEnv := &awscdk.Environment{
+			Account: env().Account,
+			Region:  jsii.String(dbGlobal.SecondaryRegion),
+		}
+
+		drNetworkStack := stacks.NewNetworkStack(app, "NetworkStack-DR", &stacks.NetworkStackProps{
+			StackProps: awscdk.StackProps{
+				Env: drEnv,
+			},
+			Environment:    environment,
+			SecurityMatrix: securityMatrix,
+			RestrictEgress: restrictEgress,
+		})
+
+		drStorageStack := stacks.NewStorageStack(app, "StorageStack-DR", &stacks.StorageStackProps{
+			StackProps: awscdk.StackProps{
+				Env: drEnv,
+			},
+			Environment:    environment,
+			VpcId:          "vpc-from-network-stack", // DRリージョンのNetworkStackを参照
+			TestEnvFlag:    false,
+			DatabaseRole:   config.DatabaseRoleSecondary,
+			RestrictEgress: restrictEgress,
+			SecurityMatrix: securityMatrix,
+		})
+
+		// セカンダリクラスターはプライマリのGlobal Database作成後にデプロイ
+		drStorageStack.AddDependency(drNetworkStack, nil)
+		drStorageStack.AddDependency(storageStack, nil)
+		dependOnKeys(drStorageStack, keyStackFor("KeyStack-DR", drEnv))
+
+		fmt.Printf("✅ DR stacks created in region: %s\n", dbGlobal.SecondaryRegion)
+	}
+
+	// 5. AWS Backup（Backup: Requiredタグが付与されたリソースをバックアップ）
+	if backupConfig := config.GetBackupConfig(environment); backupConfig.Enabled {
+		backupStack := stacks.NewBackupStack(app, "BackupStack", &stacks.BackupStackProps{
+			StackProps: awscdk.StackProps{
+				Env: env(),
+			},
+			Environment: environment,
+		})
+		dependOnKeys(backupStack, keyStack)
+
+		// クロスリージョンコピー先のボールト
+		if backupConfig.CopyRegion != "" {
+			copyEnv := &awscdk.Environment{
+				Account: env().Account,
+				Region:  jsii.String(backupConfig.CopyRegion),
+			}
+			copyBackupStack := stacks.NewBackupStack(app, "BackupStack-Copy", &stacks.BackupStackProps{
+				StackProps: awscdk.StackProps{
+					Env: copyEnv,
+				},
+				Environment:     environment,
+				CopyDestination: true,
+			})
+			backupStack.AddDependency(copyBackupStack, nil)
+			dependOnKeys(copyBackupStack, keyStackFor("KeyStack-Copy", copyEnv))
+		}
+
+		fmt.Printf("✅ BackupStack created for environment: %s\n", environment)
+	}
 
 	fmt.Printf("✅ NetworkStack created for environment: %s\n", environment)
 	fmt.Printf("✅ StorageStack created for environment: %s\n", environment)
@@ -0,0 +1,168 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// AWS Backupの対象リソースを選択するタグ（EnvironmentConfig.Tagsで付与）
+const (
+	BackupSelectionTagKey   = "Backup"
+	BackupSelectionTagValue = "Required"
+)
+
+// AWS Backupの制約（コールドストレージは移行後90日以上保持）
+const (
+	BackupColdStorageMinDays         = 90
+	BackupVaultLockMinChangeableDays = 3
+)
+
+// BackupConfig AWS Backupの設定（ボールト・バックアッププラン）
+type BackupConfig struct {
+	Enabled bool
+
+	// ボールトのロック（WORM）
+	VaultLock BackupVaultLockConfig
+
+	// バックアップルール（日次・週次・月次など）
+	Rules []BackupRuleConfig
+
+	// クロスリージョンコピー先のリージョン（空の場合はコピーしない）
+	// コピー先には同名のボールトを作成する
+	CopyRegion string
+}
+
+// BackupVaultLockConfig ボールトのロック設定
+type BackupVaultLockConfig struct {
+	Enabled          bool
+	MinRetentionDays int
+	MaxRetentionDays int // 0の場合は上限なし
+
+	// ロックが確定するまでの猶予日数（0の場合はガバナンスモードで確定しない）
+	ChangeableForDays int
+}
+
+// BackupRuleConfig バックアッププランのルール
+type BackupRuleConfig struct {
+	Name     string
+	Schedule string // cron式（UTC）
+
+	DeleteAfterDays            int
+	MoveToColdStorageAfterDays int // 0の場合は移行しない（Aurora・S3はコールドストレージ非対応のため無視される）
+
+	// コピー先での保持日数（0の場合はコピーしない）
+	CopyDeleteAfterDays int
+}
+
+// GetBackupConfig 環境別のAWS Backup設定を取得
+func GetBackupConfig(environment string) BackupConfig {
+	switch environment {
+	case "staging":
+		return BackupConfig{
+			Enabled: true,
+			Rules: []BackupRuleConfig{
+				{Name: "daily", Schedule: "cron(0 18 * * ? *)", DeleteAfterDays: 7},
+				{Name: "weekly", Schedule: "cron(0 19 ? * SUN *)", DeleteAfterDays: 35},
+			},
+		}
+	case "prod":
+		// 本番環境はロック付きボールトに保存し、月次バックアップを大阪リージョンにコピー
+		return BackupConfig{
+			Enabled: true,
+			VaultLock: BackupVaultLockConfig{
+				Enabled:           true,
+				MinRetentionDays:  7,
+				MaxRetentionDays:  3650,
+				ChangeableForDays: 3,
+			},
+			Rules: []BackupRuleConfig{
+				{Name: "daily", Schedule: "cron(0 18 * * ? *)", DeleteAfterDays: 35},
+				{Name: "weekly", Schedule: "cron(0 19 ? * SUN *)", DeleteAfterDays: 90},
+				{
+					Name:                       "monthly",
+					Schedule:                   "cron(0 20 1 * ? *)",
+					DeleteAfterDays:            365,
+					MoveToColdStorageAfterDays: 30,
+					CopyDeleteAfterDays:        365,
+				},
+			},
+			CopyRegion: "ap-northeast-3",
+		}
+	default:
+		// 開発環境はAWS Backupを使用しない
+		return BackupConfig{Enabled: false}
+	}
+}
+
+// ValidateBackupConfig AWS Backup設定の検証
+func ValidateBackupConfig(c BackupConfig) error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.CopyRegion != "" && !regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`).MatchString(c.CopyRegion) {
+		return fmt.Errorf("invalid copy region: %s", c.CopyRegion)
+	}
+
+	lock := c.VaultLock
+	if lock.Enabled {
+		if lock.MinRetentionDays < 1 {
+			return fmt.Errorf("vault lock min retention must be at least 1 day: %d", lock.MinRetentionDays)
+		}
+		if lock.MaxRetentionDays != 0 && lock.MaxRetentionDays < lock.MinRetentionDays {
+			return fmt.Errorf("vault lock max retention must not be less than min retention: %d < %d", lock.MaxRetentionDays, lock.MinRetentionDays)
+		}
+		if lock.ChangeableForDays != 0 && lock.ChangeableForDays < BackupVaultLockMinChangeableDays {
+			return fmt.Errorf("vault lock changeable days must be at least %d: %d", BackupVaultLockMinChangeableDays, lock.ChangeableForDays)
+		}
+	}
+
+	if len(c.Rules) == 0 {
+		return fmt.Errorf("backup plan requires at least one rule")
+	}
+	seen := map[string]bool{}
+	for _, r := range c.Rules {
+		if !regexp.MustCompile(`^[a-zA-Z0-9\-_.]{1,50}$`).MatchString(r.Name) {
+			return fmt.Errorf("invalid rule name: %q", r.Name)
+		}
+		if seen[r.Name] {
+			return fmt.Errorf("duplicate rule name: %s", r.Name)
+		}
+		seen[r.Name] = true
+
+		if !strings.HasPrefix(r.Schedule, "cron(") || !strings.HasSuffix(r.Schedule, ")") {
+			return fmt.Errorf("%s: schedule must be a cron expression: %s", r.Name, r.Schedule)
+		}
+		if r.DeleteAfterDays < 1 {
+			return fmt.Errorf("%s: retention must be at least 1 day: %d", r.Name, r.DeleteAfterDays)
+		}
+		if r.MoveToColdStorageAfterDays < 0 {
+			return fmt.Errorf("%s: cold storage transition must not be negative: %d", r.Name, r.MoveToColdStorageAfterDays)
+		}
+		if r.MoveToColdStorageAfterDays > 0 && r.DeleteAfterDays < r.MoveToColdStorageAfterDays+BackupColdStorageMinDays {
+			return fmt.Errorf("%s: retention must be at least %d days after cold storage transition: %d", r.Name, BackupColdStorageMinDays, r.DeleteAfterDays)
+		}
+		if r.CopyDeleteAfterDays < 0 {
+			return fmt.Errorf("%s: copy retention must not be negative: %d", r.Name, r.CopyDeleteAfterDays)
+		}
+		if r.CopyDeleteAfterDays > 0 && c.CopyRegion == "" {
+			return fmt.Errorf("%s: cross-region copy requires copy region", r.Name)
+		}
+
+		// ロック付きボールトは保持期間が範囲外の復旧ポイントを受け付けない（コピー先も同じロック設定）
+		if lock.Enabled {
+			retentions := []int{r.DeleteAfterDays}
+			if r.CopyDeleteAfterDays > 0 {
+				retentions = append(retentions, r.CopyDeleteAfterDays)
+			}
+			for _, days := range retentions {
+				if days < lock.MinRetentionDays || (lock.MaxRetentionDays != 0 && days > lock.MaxRetentionDays) {
+					return fmt.Errorf("%s: retention %d days is outside vault lock range", r.Name, days)
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,392 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// S3ストレージクラス（ライフサイクルの移行先、コスト順）
+const (
+	StorageClassInfrequentAccess        = "STANDARD_IA"
+	StorageClassGlacierInstantRetrieval = "GLACIER_IR"
+	StorageClassDeepArchive             = "DEEP_ARCHIVE"
+)
+
+// S3 Object Lockのモード
+const (
+	ObjectLockGovernance = "GOVERNANCE" // 特別な権限（s3:BypassGovernanceRetention）があれば削除可能
+	ObjectLockCompliance = "COMPLIANCE" // 保持期間中はrootユーザーを含め誰も削除できない
+)
+
+// ログ用バケットのプレフィックス（バケットポリシーは各プレフィックスにのみ書き込みを許可）
+const (
+	LogsPrefixALBAccess     = "alb/access"     // ALBのアクセスログ
+	LogsPrefixALBConnection = "alb/connection" // ALBの接続ログ
+	LogsPrefixS3Access      = "s3-access"      // S3サーバーアクセスログ（バケット別のサブプレフィックス）
+)
+
+// lifecycleStorageClasses 移行先として設定可能なストレージクラス（この順にのみ移行できる）
+var lifecycleStorageClasses = []string{
+	StorageClassInfrequentAccess,
+	StorageClassGlacierInstantRetrieval,
+	StorageClassDeepArchive,
+}
+
+// StorageBucketsConfig StorageStackのS3バケット別の設定
+type StorageBucketsConfig struct {
+	StaticAssets BucketConfig
+	Logs         BucketConfig
+	Backups      BucketConfig
+}
+
+// BucketConfig S3バケットの設定
+type BucketConfig struct {
+	Versioned   bool
+	Lifecycle   BucketLifecycleConfig
+	Replication BucketReplicationConfig // バックアップ用バケットのみ
+	ObjectLock  BucketObjectLockConfig  // バックアップ用バケットのみ
+
+	// ログ用バケットにサーバーアクセスログを出力（ログの無限ループになるためログ用バケット自体は不可）
+	ServerAccessLogs bool
+}
+
+// BucketLifecycleConfig S3バケットのライフサイクル設定（日数はオブジェクト作成・非最新化からの経過日数）
+type BucketLifecycleConfig struct {
+	Transitions    []BucketTransitionConfig // 現行バージョンの移行（日数順）
+	ExpirationDays int                      // 0の場合は削除しない
+
+	// 非最新バージョン（バージョニング有効時のみ）
+	NoncurrentTransitions           []BucketTransitionConfig
+	NoncurrentVersionExpirationDays int // 0の場合は削除しない
+	NoncurrentVersionsToRetain      int // 削除対象外とする新しい非最新バージョンの数
+
+	// 未完了のマルチパートアップロードを中止するまでの日数（0の場合は中止しない）
+	AbortIncompleteMultipartUploadDays int
+}
+
+// BucketTransitionConfig ストレージクラスの移行
+type BucketTransitionConfig struct {
+	StorageClass string
+	Days         int
+}
+
+// BucketReplicationConfig S3レプリケーションの設定（バージョニング有効時のみ）
+// 宛先バケット・KMSキーは事前に作成しておく（宛先バケットもバージョニング有効）
+type BucketReplicationConfig struct {
+	Destinations []BucketReplicationDestinationConfig // 空の場合はレプリケーションしない（先頭ほど優先度が高い）
+}
+
+// BucketReplicationDestinationConfig S3レプリケーションの宛先
+type BucketReplicationDestinationConfig struct {
+	BucketName string
+	Region     string // 未指定の場合はStackと同じリージョン
+	Account    string // 別アカウント（バックアップ専用アカウント）の場合のみ指定
+
+	// レプリカを再暗号化する宛先リージョンのKMSキーのエイリアス（alias/...）
+	KmsKeyAlias string
+
+	StorageClass           string // 未指定の場合は元のオブジェクトと同じ
+	ReplicationTimeControl bool   // S3 RTC（15分以内のレプリケーション、メトリクスも有効化）
+	OwnerOverride          bool   // レプリカの所有者を宛先アカウントに変更（別アカウントの場合のみ）
+}
+
+// BucketObjectLockConfig S3 Object Lock（WORM）の設定
+// 既存のバケットには有効化できないため、新しいバケット名で作り直す（既存のバケットはRETAINで残る）
+type BucketObjectLockConfig struct {
+	Enabled       bool
+	Mode          string // GOVERNANCE, COMPLIANCE
+	RetentionDays int    // 既定の保持期間
+	BucketName    string // Object Lockを有効にした新しいバケットの名前（必須）
+}
+
+// IsEmpty ライフサイクルルールが不要か
+func (c BucketLifecycleConfig) IsEmpty() bool {
+	return len(c.Transitions) == 0 && c.ExpirationDays == 0 &&
+		len(c.NoncurrentTransitions) == 0 && c.NoncurrentVersionExpirationDays == 0 &&
+		c.AbortIncompleteMultipartUploadDays == 0
+}
+
+// GetStorageBucketsConfig 環境別のS3バケット設定を取得
+func GetStorageBucketsConfig(environment string) StorageBucketsConfig {
+	switch environment {
+	case "staging":
+		return StorageBucketsConfig{
+			StaticAssets: BucketConfig{
+				Versioned:        true,
+				ServerAccessLogs: true,
+				Lifecycle: BucketLifecycleConfig{
+					NoncurrentVersionExpirationDays:    30,
+					AbortIncompleteMultipartUploadDays: 7,
+				},
+			},
+			Logs: BucketConfig{
+				Lifecycle: BucketLifecycleConfig{
+					Transitions: []BucketTransitionConfig{
+						{StorageClass: StorageClassInfrequentAccess, Days: 30},
+					},
+					ExpirationDays:                     90,
+					AbortIncompleteMultipartUploadDays: 1,
+				},
+			},
+			Backups: BucketConfig{
+				Versioned:        true,
+				ServerAccessLogs: true,
+				Lifecycle: BucketLifecycleConfig{
+					Transitions: []BucketTransitionConfig{
+						{StorageClass: StorageClassGlacierInstantRetrieval, Days: 30},
+					},
+					ExpirationDays:                     180,
+					NoncurrentVersionExpirationDays:    30,
+					AbortIncompleteMultipartUploadDays: 7,
+				},
+			},
+		}
+	case "prod":
+		return StorageBucketsConfig{
+			StaticAssets: BucketConfig{
+				Versioned:        true,
+				ServerAccessLogs: true,
+				Lifecycle: BucketLifecycleConfig{
+					// ロールバック用に直近のバージョンは期間によらず保持
+					NoncurrentVersionExpirationDays:    90,
+					NoncurrentVersionsToRetain:         3,
+					AbortIncompleteMultipartUploadDays: 7,
+				},
+			},
+			Logs: BucketConfig{
+				// 監査対応のため2年間保持
+				Lifecycle: BucketLifecycleConfig{
+					Transitions: []BucketTransitionConfig{
+						{StorageClass: StorageClassInfrequentAccess, Days: 30},
+						{StorageClass: StorageClassGlacierInstantRetrieval, Days: 90},
+						{StorageClass: StorageClassDeepArchive, Days: 180},
+					},
+					ExpirationDays:                     730,
+					AbortIncompleteMultipartUploadDays: 1,
+				},
+			},
+			Backups: BucketConfig{
+				// 現行バージョンは削除せず、非最新バージョンのみ1年で削除
+				// 大阪リージョンにDR用のレプリカを保持
+				Versioned:        true,
+				ServerAccessLogs: true,
+				Lifecycle: BucketLifecycleConfig{
+					Transitions: []BucketTransitionConfig{
+						{StorageClass: StorageClassInfrequentAccess, Days: 30},
+						{StorageClass: StorageClassGlacierInstantRetrieval, Days: 90},
+						{StorageClass: StorageClassDeepArchive, Days: 365},
+					},
+					NoncurrentTransitions: []BucketTransitionConfig{
+						{StorageClass: StorageClassGlacierInstantRetrieval, Days: 30},
+					},
+					NoncurrentVersionExpirationDays:    365,
+					NoncurrentVersionsToRetain:         3,
+					AbortIncompleteMultipartUploadDays: 7,
+				},
+				Replication: BucketReplicationConfig{
+					Destinations: []BucketReplicationDestinationConfig{
+						{
+							BucketName:             "service-production-backups-dr",
+							Region:                 "ap-northeast-3",
+							KmsKeyAlias:            "alias/service-production-backups-dr",
+							StorageClass:           StorageClassGlacierInstantRetrieval,
+							ReplicationTimeControl: true,
+						},
+					},
+				},
+			},
+		}
+	default:
+		return StorageBucketsConfig{
+			StaticAssets: BucketConfig{
+				Versioned:        true,
+				ServerAccessLogs: true,
+				Lifecycle: BucketLifecycleConfig{
+					NoncurrentVersionExpirationDays:    7,
+					AbortIncompleteMultipartUploadDays: 1,
+				},
+			},
+			Logs: BucketConfig{
+				Lifecycle: BucketLifecycleConfig{
+					ExpirationDays:                     30,
+					AbortIncompleteMultipartUploadDays: 1,
+				},
+			},
+			Backups: BucketConfig{
+				Versioned:        true,
+				ServerAccessLogs: true,
+				Lifecycle: BucketLifecycleConfig{
+					ExpirationDays:                     30,
+					NoncurrentVersionExpirationDays:    7,
+					AbortIncompleteMultipartUploadDays: 1,
+				},
+			},
+		}
+	}
+}
+
+// ValidateStorageBucketsConfig S3バケット設定の検証
+func ValidateStorageBucketsConfig(c StorageBucketsConfig) error {
+	buckets := []struct {
+		name   string
+		config BucketConfig
+	}{
+		{"static assets", c.StaticAssets},
+		{"logs", c.Logs},
+		{"backups", c.Backups},
+	}
+	for _, bucket := range buckets {
+		if err := ValidateBucketConfig(bucket.config); err != nil {
+			return fmt.Errorf("%s bucket: %w", bucket.name, err)
+		}
+		if bucket.name != "backups" && len(bucket.config.Replication.Destinations) > 0 {
+			return fmt.Errorf("%s bucket: replication is only supported for the backups bucket", bucket.name)
+		}
+		if bucket.name != "backups" && bucket.config.ObjectLock.Enabled {
+			return fmt.Errorf("%s bucket: object lock is only supported for the backups bucket", bucket.name)
+		}
+		if bucket.name == "logs" && bucket.config.ServerAccessLogs {
+			return fmt.Errorf("logs bucket must not write server access logs to itself")
+		}
+	}
+	return nil
+}
+
+// ValidateBucketConfig S3バケット設定の検証
+func ValidateBucketConfig(c BucketConfig) error {
+	lc := c.Lifecycle
+
+	if err := validateBucketTransitions(lc.Transitions); err != nil {
+		return err
+	}
+	if lc.ExpirationDays < 0 {
+		return fmt.Errorf("expiration days must not be negative: %d", lc.ExpirationDays)
+	}
+	if n := len(lc.Transitions); n > 0 && lc.ExpirationDays != 0 && lc.ExpirationDays <= lc.Transitions[n-1].Days {
+		return fmt.Errorf("expiration (%d days) must be after the last transition (%d days)", lc.ExpirationDays, lc.Transitions[n-1].Days)
+	}
+
+	// 非最新バージョンのルールはバージョニング有効時のみ
+	hasNoncurrentRules := len(lc.NoncurrentTransitions) > 0 || lc.NoncurrentVersionExpirationDays != 0 || lc.NoncurrentVersionsToRetain != 0
+	if hasNoncurrentRules && !c.Versioned {
+		return fmt.Errorf("noncurrent version rules require versioning")
+	}
+	if err := validateBucketTransitions(lc.NoncurrentTransitions); err != nil {
+		return fmt.Errorf("noncurrent %w", err)
+	}
+	if lc.NoncurrentVersionExpirationDays < 0 {
+		return fmt.Errorf("noncurrent version expiration days must not be negative: %d", lc.NoncurrentVersionExpirationDays)
+	}
+	if n := len(lc.NoncurrentTransitions); n > 0 && lc.NoncurrentVersionExpirationDays != 0 && lc.NoncurrentVersionExpirationDays <= lc.NoncurrentTransitions[n-1].Days {
+		return fmt.Errorf("noncurrent version expiration (%d days) must be after the last transition (%d days)", lc.NoncurrentVersionExpirationDays, lc.NoncurrentTransitions[n-1].Days)
+	}
+	if lc.NoncurrentVersionsToRetain < 0 || lc.NoncurrentVersionsToRetain > 100 {
+		return fmt.Errorf("noncurrent versions to retain must be within 0-100: %d", lc.NoncurrentVersionsToRetain)
+	}
+	if lc.NoncurrentVersionsToRetain > 0 && lc.NoncurrentVersionExpirationDays == 0 {
+		return fmt.Errorf("noncurrent versions to retain requires noncurrent version expiration")
+	}
+
+	if lc.AbortIncompleteMultipartUploadDays < 0 {
+		return fmt.Errorf("abort incomplete multipart upload days must not be negative: %d", lc.AbortIncompleteMultipartUploadDays)
+	}
+
+	if len(c.Replication.Destinations) > 0 && !c.Versioned {
+		return fmt.Errorf("replication requires versioning")
+	}
+	if err := ValidateBucketReplicationConfig(c.Replication); err != nil {
+		return fmt.Errorf("replication: %w", err)
+	}
+
+	if c.ObjectLock.Enabled && !c.Versioned {
+		return fmt.Errorf("object lock requires versioning")
+	}
+	if err := ValidateBucketObjectLockConfig(c.ObjectLock); err != nil {
+		return fmt.Errorf("object lock: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateBucketObjectLockConfig S3 Object Lock設定の検証
+func ValidateBucketObjectLockConfig(c BucketObjectLockConfig) error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.Mode != ObjectLockGovernance && c.Mode != ObjectLockCompliance {
+		return fmt.Errorf("unsupported object lock mode: %s", c.Mode)
+	}
+	if c.RetentionDays < 1 || c.RetentionDays > 36500 {
+		return fmt.Errorf("object lock retention days must be within 1-36500: %d", c.RetentionDays)
+	}
+	if c.BucketName == "" {
+		return fmt.Errorf("object lock requires a new bucket name")
+	}
+
+	return nil
+}
+
+// ValidateBucketReplicationConfig S3レプリケーション設定の検証
+func ValidateBucketReplicationConfig(c BucketReplicationConfig) error {
+	seen := map[string]bool{}
+	for _, d := range c.Destinations {
+		if d.BucketName == "" {
+			return fmt.Errorf("destination bucket name is required")
+		}
+		if seen[d.BucketName] {
+			return fmt.Errorf("duplicate destination bucket: %s", d.BucketName)
+		}
+		seen[d.BucketName] = true
+
+		if d.Region != "" && !regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`).MatchString(d.Region) {
+			return fmt.Errorf("invalid destination region: %q", d.Region)
+		}
+		if d.Account != "" && !regexp.MustCompile(`^\d{12}$`).MatchString(d.Account) {
+			return fmt.Errorf("invalid destination account: %q", d.Account)
+		}
+		if d.OwnerOverride && d.Account == "" {
+			return fmt.Errorf("owner override requires a destination account: %s", d.BucketName)
+		}
+
+		// 元のバケットはSSE-KMSのため、レプリカの暗号化キーが必須
+		if !strings.HasPrefix(d.KmsKeyAlias, "alias/") || strings.HasPrefix(d.KmsKeyAlias, "alias/aws/") {
+			return fmt.Errorf("destination requires a customer managed KMS key alias: %q", d.KmsKeyAlias)
+		}
+
+		if d.StorageClass != "" && !slices.Contains(lifecycleStorageClasses, d.StorageClass) {
+			return fmt.Errorf("unsupported replication storage class: %s", d.StorageClass)
+		}
+	}
+	return nil
+}
+
+// validateBucketTransitions ストレージクラスの移行順序・日数の検証
+// STANDARD_IAへは作成から30日以上、STANDARD_IAからの次の移行は30日以上後（S3の制約）
+func validateBucketTransitions(transitions []BucketTransitionConfig) error {
+	previousClass := -1
+	previousDays := 0
+	for i, t := range transitions {
+		class := slices.Index(lifecycleStorageClasses, t.StorageClass)
+		if class < 0 {
+			return fmt.Errorf("unsupported lifecycle storage class: %s", t.StorageClass)
+		}
+		if class <= previousClass {
+			return fmt.Errorf("transition to %s must come after %s", t.StorageClass, lifecycleStorageClasses[previousClass])
+		}
+		if t.StorageClass == StorageClassInfrequentAccess && t.Days < 30 {
+			return fmt.Errorf("transition to %s requires at least 30 days: %d", t.StorageClass, t.Days)
+		}
+		if t.Days < 0 || (i > 0 && t.Days <= previousDays) {
+			return fmt.Errorf("transition days must be increasing: %d", t.Days)
+		}
+		if i > 0 && lifecycleStorageClasses[previousClass] == StorageClassInfrequentAccess && t.Days < previousDays+30 {
+			return fmt.Errorf("transition to %s must be at least 30 days after %s: %d", t.StorageClass, StorageClassInfrequentAccess, t.Days)
+		}
+		previousClass = class
+		previousDays = t.Days
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// CloudFrontの価格クラス
+const (
+	CDNPriceClass100 = "PriceClass_100" // 北米・欧州のみ
+	CDNPriceClass200 = "PriceClass_200" // 日本を含むアジアまで
+	CDNPriceClassAll = "PriceClass_All"
+)
+
+// CDNConfig 静的アセット配信用CloudFrontの設定
+type CDNConfig struct {
+	Enabled    bool
+	PriceClass string
+
+	// カスタムドメイン（未指定の場合はCloudFrontのドメイン）
+	// 証明書はCloudFrontの制約によりus-east-1のACM証明書を指定
+	DomainName     string
+	CertificateArn string
+
+	// キャッシュ設定（Behaviorsはパス別、それ以外はDefaultCacheを使用）
+	DefaultCache CDNCacheConfig
+	Behaviors    []CDNBehaviorConfig
+}
+
+// CDNCacheConfig キャッシュポリシーのTTL（オリジンのCache-Controlがない場合はDefaultTTLを使用）
+type CDNCacheConfig struct {
+	MinTTLSeconds     int
+	DefaultTTLSeconds int
+	MaxTTLSeconds     int
+}
+
+// CDNBehaviorConfig パス別のキャッシュ設定
+type CDNBehaviorConfig struct {
+	PathPattern string
+	Cache       CDNCacheConfig
+}
+
+// cdnBehaviors 全環境共通のパス別キャッシュ設定
+func cdnBehaviors() []CDNBehaviorConfig {
+	return []CDNBehaviorConfig{
+		// ビルド成果物（Viteのハッシュ付きファイル名）は1年間キャッシュ
+		{PathPattern: "/build/*", Cache: CDNCacheConfig{DefaultTTLSeconds: 31536000, MaxTTLSeconds: 31536000}},
+		{PathPattern: "/images/*", Cache: CDNCacheConfig{DefaultTTLSeconds: 604800, MaxTTLSeconds: 31536000}},
+	}
+}
+
+// GetCDNConfig 環境別のCloudFront設定を取得
+func GetCDNConfig(environment string) CDNConfig {
+	switch environment {
+	case "staging":
+		return CDNConfig{
+			Enabled:      true,
+			PriceClass:   CDNPriceClass200,
+			DefaultCache: CDNCacheConfig{DefaultTTLSeconds: 3600, MaxTTLSeconds: 86400},
+			Behaviors:    cdnBehaviors(),
+		}
+	case "prod":
+		return CDNConfig{
+			Enabled:      true,
+			PriceClass:   CDNPriceClassAll,
+			DefaultCache: CDNCacheConfig{DefaultTTLSeconds: 86400, MaxTTLSeconds: 31536000},
+			Behaviors:    cdnBehaviors(),
+		}
+	default:
+		// 開発環境はデプロイ直後に確認できるよう短いTTL
+		return CDNConfig{
+			Enabled:      true,
+			PriceClass:   CDNPriceClass200,
+			DefaultCache: CDNCacheConfig{DefaultTTLSeconds: 60, MaxTTLSeconds: 300},
+			Behaviors:    cdnBehaviors(),
+		}
+	}
+}
+
+// ValidateCDNConfig CloudFront設定の検証
+func ValidateCDNConfig(c CDNConfig) error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if !slices.Contains([]string{CDNPriceClass100, CDNPriceClass200, CDNPriceClassAll}, c.PriceClass) {
+		return fmt.Errorf("unsupported price class: %s", c.PriceClass)
+	}
+
+	// カスタムドメインと証明書は両方指定
+	if (c.DomainName == "") != (c.CertificateArn == "") {
+		return fmt.Errorf("custom domain requires both domain name and certificate ARN")
+	}
+	if c.CertificateArn != "" && !regexp.MustCompile(`^arn:aws:acm:us-east-1:\d{12}:certificate/[0-9a-f-]+$`).MatchString(c.CertificateArn) {
+		return fmt.Errorf("certificate must be an ACM certificate in us-east-1: %s", c.CertificateArn)
+	}
+
+	if err := validateCDNCacheConfig(c.DefaultCache); err != nil {
+		return fmt.Errorf("default cache: %w", err)
+	}
+	seen := map[string]bool{}
+	for _, b := range c.Behaviors {
+		if !strings.HasPrefix(b.PathPattern, "/") || b.PathPattern == "/*" {
+			return fmt.Errorf("invalid path pattern: %q", b.PathPattern)
+		}
+		if seen[b.PathPattern] {
+			return fmt.Errorf("duplicate path pattern: %s", b.PathPattern)
+		}
+		seen[b.PathPattern] = true
+
+		if err := validateCDNCacheConfig(b.Cache); err != nil {
+			return fmt.Errorf("%s: %w", b.PathPattern, err)
+		}
+	}
+
+	return nil
+}
+
+// validateCDNCacheConfig TTLの検証（最小 <= 既定 <= 最大）
+func validateCDNCacheConfig(c CDNCacheConfig) error {
+	if c.MinTTLSeconds < 0 {
+		return fmt.Errorf("min TTL must not be negative: %d", c.MinTTLSeconds)
+	}
+	if c.DefaultTTLSeconds < c.MinTTLSeconds || c.MaxTTLSeconds < c.DefaultTTLSeconds {
+		return fmt.Errorf("TTL must satisfy min <= default <= max: %d, %d, %d", c.MinTTLSeconds, c.DefaultTTLSeconds, c.MaxTTLSeconds)
+	}
+	return nil
+}
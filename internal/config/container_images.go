@@ -0,0 +1,41 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PublicECRRegistry ECR Publicのレジストリ（Docker Hubの公式イメージはdocker/library配下にミラーされている）
+const PublicECRRegistry = "public.ecr.aws"
+
+// PullThroughCachePrefix Egress制限時にECR Publicのイメージを取得するプルスルーキャッシュのリポジトリプレフィックス
+func PullThroughCachePrefix(environment string) string {
+	return "service-" + environment + "-ecr-public"
+}
+
+// ParsePublicImage パブリックレジストリのイメージをECR Public上のリポジトリ名とタグに変換
+// Docker Hubの公式イメージ（nginx:1.24-alpine など）はECR Publicのdocker/libraryを使用
+func ParsePublicImage(image string) (string, string, error) {
+	if strings.Contains(image, "@") {
+		return "", "", fmt.Errorf("image digest is not supported: %s", image)
+	}
+
+	name, tag := image, "latest"
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		name, tag = image[:i], image[i+1:]
+	}
+	if name == "" || tag == "" {
+		return "", "", fmt.Errorf("invalid image: %s", image)
+	}
+
+	switch {
+	case strings.HasPrefix(name, PublicECRRegistry+"/"):
+		return strings.TrimPrefix(name, PublicECRRegistry+"/"), tag, nil
+	case !strings.Contains(name, "/"):
+		return "docker/library/" + name, tag, nil
+	case strings.HasPrefix(name, "library/") && strings.Count(name, "/") == 1:
+		return "docker/" + name, tag, nil
+	default:
+		return "", "", fmt.Errorf("only Docker Hub official images and %s images are supported: %s", PublicECRRegistry, image)
+	}
+}
@@ -0,0 +1,243 @@
+package config
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// パラメータグループの適用範囲
+const (
+	ParameterScopeCluster  = "cluster"
+	ParameterScopeInstance = "instance"
+)
+
+// DatabaseParametersConfig Auroraのパラメータグループ設定（エンジン既定値に環境別の値を上書き）
+type DatabaseParametersConfig struct {
+	Cluster  map[string]string // クラスターパラメータグループ
+	Instance map[string]string // DBパラメータグループ（Writer・Reader共通）
+}
+
+// DatabaseParameterDiff 環境間で実効値が異なるパラメータ
+type DatabaseParameterDiff struct {
+	Scope  string // cluster, instance
+	Name   string
+	Base   string // 未設定の場合は空（AWSの既定値）
+	Target string
+}
+
+// エンジンファミリーごとに設定を許可するパラメータ（適用範囲別）
+var supportedDatabaseParameters = map[string]map[string][]string{
+	DatabaseEngineAuroraMySQL: {
+		ParameterScopeCluster: {
+			"time_zone", "character_set_server", "collation_server",
+			"character_set_client", "character_set_connection", "character_set_database", "character_set_results",
+			"collation_connection", "binlog_format", "require_secure_transport",
+			"server_audit_logging", "server_audit_events", "server_audit_excl_users",
+		},
+		ParameterScopeInstance: {
+			"max_connections", "slow_query_log", "long_query_time", "log_queries_not_using_indexes",
+			"general_log", "log_output", "wait_timeout", "interactive_timeout",
+			"innodb_lock_wait_timeout", "performance_schema", "sql_mode",
+		},
+	},
+	DatabaseEngineAuroraPostgreSQL: {
+		ParameterScopeCluster: {
+			"timezone", "client_encoding", "rds.force_ssl", "rds.logical_replication",
+			"log_statement", "log_min_duration_statement", "shared_preload_libraries", "pgaudit.log",
+		},
+		ParameterScopeInstance: {
+			"max_connections", "log_min_duration_statement", "log_connections", "log_disconnections",
+			"work_mem", "idle_in_transaction_session_timeout", "statement_timeout",
+		},
+	},
+}
+
+// GetDatabaseParametersConfig 環境・エンジン別のパラメータ設定を取得
+func GetDatabaseParametersConfig(environment string, engine string, version string) DatabaseParametersConfig {
+	params := defaultDatabaseParameters(engine, version)
+
+	var overrides DatabaseParametersConfig
+	if engine == DatabaseEngineAuroraPostgreSQL {
+		overrides = getPostgreSQLParameterOverrides(environment)
+	} else {
+		overrides = getMySQLParameterOverrides(environment)
+	}
+	maps.Copy(params.Cluster, overrides.Cluster)
+	maps.Copy(params.Instance, overrides.Instance)
+
+	return params
+}
+
+// defaultDatabaseParameters エンジン別の既定パラメータ（全環境共通）
+// 既存のテーブルの文字セット・照合順序は変更されないため、変換はマイグレーションで行う
+func defaultDatabaseParameters(engine string, version string) DatabaseParametersConfig {
+	if engine == DatabaseEngineAuroraPostgreSQL {
+		return DatabaseParametersConfig{
+			Cluster: map[string]string{
+				"timezone": "Asia/Tokyo",
+			},
+			Instance: map[string]string{},
+		}
+	}
+	return DatabaseParametersConfig{
+		Cluster: map[string]string{
+			"time_zone":            "Asia/Tokyo",
+			"character_set_server": "utf8mb4",
+			"collation_server":     mysqlCollation(version),
+		},
+		Instance: map[string]string{},
+	}
+}
+
+// mysqlCollation utf8mb4の照合順序（utf8mb4_0900_ai_ciはMySQL 8.0互換の3系のみ）
+func mysqlCollation(version string) string {
+	if mysqlCompatibleVersion(version) == "5.7" {
+		return "utf8mb4_general_ci"
+	}
+	return "utf8mb4_0900_ai_ci"
+}
+
+// getMySQLParameterOverrides 環境別のAurora MySQLパラメータ
+func getMySQLParameterOverrides(environment string) DatabaseParametersConfig {
+	switch environment {
+	case "dev":
+		// 開発環境はスロークエリの閾値を低くして問題を早期に検出
+		return DatabaseParametersConfig{
+			Instance: map[string]string{
+				"slow_query_log":                "1",
+				"long_query_time":               "0.5",
+				"log_queries_not_using_indexes": "1",
+			},
+		}
+	case "staging":
+		return DatabaseParametersConfig{
+			Instance: map[string]string{
+				"slow_query_log":  "1",
+				"long_query_time": "1",
+			},
+		}
+	case "prod":
+		// 本番環境はRDS Proxyのプール上限と合わせて接続数を固定
+		return DatabaseParametersConfig{
+			Cluster: map[string]string{
+				"require_secure_transport": "ON",
+			},
+			Instance: map[string]string{
+				"slow_query_log":  "1",
+				"long_query_time": "2",
+				"max_connections": "1000",
+			},
+		}
+	default:
+		return DatabaseParametersConfig{}
+	}
+}
+
+// getPostgreSQLParameterOverrides 環境別のAurora PostgreSQLパラメータ
+func getPostgreSQLParameterOverrides(environment string) DatabaseParametersConfig {
+	switch environment {
+	case "dev":
+		return DatabaseParametersConfig{
+			Instance: map[string]string{
+				"log_min_duration_statement": "500",
+			},
+		}
+	case "staging":
+		return DatabaseParametersConfig{
+			Instance: map[string]string{
+				"log_min_duration_statement": "1000",
+			},
+		}
+	case "prod":
+		return DatabaseParametersConfig{
+			Cluster: map[string]string{
+				"rds.force_ssl": "1",
+			},
+			Instance: map[string]string{
+				"log_min_duration_statement": "2000",
+				"max_connections":            "1000",
+			},
+		}
+	default:
+		return DatabaseParametersConfig{}
+	}
+}
+
+// ValidateDatabaseParametersConfig パラメータがエンジンファミリー・適用範囲で設定可能かを検証
+func ValidateDatabaseParametersConfig(engine string, c DatabaseParametersConfig) error {
+	supported, ok := supportedDatabaseParameters[engine]
+	if !ok {
+		return fmt.Errorf("unsupported database engine: %s", engine)
+	}
+
+	scopes := []struct {
+		name   string
+		values map[string]string
+	}{
+		{ParameterScopeCluster, c.Cluster},
+		{ParameterScopeInstance, c.Instance},
+	}
+	for _, scope := range scopes {
+		for _, name := range slices.Sorted(maps.Keys(scope.values)) {
+			if !slices.Contains(supported[scope.name], name) {
+				return fmt.Errorf("parameter %s is not supported in %s %s parameter group", name, engine, scope.name)
+			}
+			if scope.values[name] == "" {
+				return fmt.Errorf("parameter %s in %s parameter group has an empty value", name, scope.name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// DiffDatabaseParameters 2つのAurora設定で実効値が異なるパラメータを取得（適用範囲・名前順）
+func DiffDatabaseParameters(base *DatabaseConfig, target *DatabaseConfig) []DatabaseParameterDiff {
+	diffs := diffParameterScope(ParameterScopeCluster, base.ClusterParameters(), target.ClusterParameters())
+	return append(diffs, diffParameterScope(ParameterScopeInstance, base.Parameters.Instance, target.Parameters.Instance)...)
+}
+
+// DiffEnvironmentDatabaseParameters 環境間のパラメータ差分を取得（環境設定のエンジンで比較）
+func DiffEnvironmentDatabaseParameters(baseEnvironment string, targetEnvironment string) []DatabaseParameterDiff {
+	return DiffDatabaseParameters(GetDatabaseConfig(baseEnvironment), GetDatabaseConfig(targetEnvironment))
+}
+
+// diffParameterScope 適用範囲内のパラメータ差分を取得
+func diffParameterScope(scope string, base map[string]string, target map[string]string) []DatabaseParameterDiff {
+	names := slices.Collect(maps.Keys(base))
+	for name := range target {
+		if _, ok := base[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	diffs := []DatabaseParameterDiff{}
+	for _, name := range names {
+		if base[name] != target[name] {
+			diffs = append(diffs, DatabaseParameterDiff{Scope: scope, Name: name, Base: base[name], Target: target[name]})
+		}
+	}
+	return diffs
+}
+
+// RenderDatabaseParameterDiffMarkdown パラメータ差分をMarkdown形式で出力（環境間のレビュー用）
+func RenderDatabaseParameterDiffMarkdown(baseName string, targetName string, diffs []DatabaseParameterDiff) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "| Scope | Parameter | %s | %s |\n", baseName, targetName)
+	b.WriteString("|---|---|---|---|\n")
+	for _, d := range diffs {
+		fmt.Fprintf(&b, "| %s | %s | %s | %s |\n", d.Scope, d.Name, displayParameterValue(d.Base), displayParameterValue(d.Target))
+	}
+	return b.String()
+}
+
+// displayParameterValue 未設定のパラメータはAWSの既定値として表示
+func displayParameterValue(value string) string {
+	if value == "" {
+		return "(default)"
+	}
+	return value
+}
@@ -0,0 +1,180 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+)
+
+// データ分類（分類ごとにカスタマー管理キーを作成）
+const (
+	DataClassDatabase  = "database"  // Aurora（ストレージ・Performance Insights）
+	DataClassCache     = "cache"     // ElastiCache
+	DataClassLogs      = "logs"      // CloudWatch Logs
+	DataClassBackups   = "backups"   // バックアップ用S3バケット・AWS Backupのボールト
+	DataClassSecrets   = "secrets"   // Secrets Manager
+	DataClassArtifacts = "artifacts" // ECRのコンテナイメージ
+	DataClassFiles     = "files"     // EFS（タスク間の共有ファイル）
+)
+
+// KMSの制約
+const (
+	KeyRotationMinDays   = 90
+	KeyRotationMaxDays   = 2560
+	KeyPendingWindowMin  = 7
+	KeyPendingWindowMax  = 30
+	defaultKeyRotation   = 365
+	defaultPendingWindow = 30
+)
+
+// CMKへの変更でCloudFormationがリソースを置換するデータ分類
+// Aurora（クラスター識別子）・ElastiCache（レプリケーショングループID）・ECR（リポジトリ名）は物理名が固定のため、
+// 既存のリソースのままキーを変更すると更新に失敗する（本番環境のAuroraは削除保護も有効）
+var replacedDataClasses = []string{DataClassDatabase, DataClassCache, DataClassArtifacts}
+
+// migrationNameSuffixPattern 移行後の物理名の接尾辞（ElastiCacheのID上限40文字に収まる長さ）
+var migrationNameSuffixPattern = regexp.MustCompile(`^[a-z][a-z0-9]{0,7}$`)
+
+// DataClasses 全データ分類
+func DataClasses() []string {
+	return []string{
+		DataClassDatabase,
+		DataClassCache,
+		DataClassLogs,
+		DataClassBackups,
+		DataClassSecrets,
+		DataClassArtifacts,
+		DataClassFiles,
+	}
+}
+
+// EncryptionConfig データ分類別のカスタマー管理キー（CMK）の設定
+type EncryptionConfig struct {
+	// CMKを使用するデータ分類（含まれない分類はAWS管理キーまたはS3管理キーを使用）
+	DataClasses []string
+
+	// 自動ローテーションの間隔・削除待機期間（0の場合は既定値）
+	RotationPeriodDays int
+	PendingWindowDays  int
+
+	// Aurora・ElastiCache・ECRにCMKを使用する場合の移行設定（既存のリソースは置換できないため必須）
+	Migration EncryptionMigrationConfig
+}
+
+// EncryptionMigrationConfig AWS管理キーで作成済みのリソースをCMKで作成し直す設定
+// 既存の物理名に接尾辞を付けた新しいリソースを作成し、既存のリソースは移行の確認後に手動で削除する
+// 手順はStorageStackのEncryptionMigrationRunbook出力を参照
+type EncryptionMigrationConfig struct {
+	NameSuffix string // 新しい物理名の接尾辞（例: cmk → service-production-aurora-cluster-cmk）
+
+	// 移行前に既存のAurora・ElastiCacheから取得したスナップショットから復元（falseの場合は空のリソースを作成）
+	// スナップショット名は既存の物理名 + "-before-" + 接尾辞
+	RestoreFromSnapshots bool
+}
+
+// GetEncryptionConfig 環境別の暗号化設定を取得
+func GetEncryptionConfig(environment string) EncryptionConfig {
+	switch environment {
+	case "staging":
+		// ステージング環境のデータは本番環境から作成し直せるため、新しいリソースは空で作成
+		return EncryptionConfig{
+			DataClasses:        DataClasses(),
+			RotationPeriodDays: 365,
+			PendingWindowDays:  7,
+			Migration:          EncryptionMigrationConfig{NameSuffix: "cmk"},
+		}
+	case "prod":
+		// 本番環境は書き込みを停止して取得したスナップショットから復元
+		return EncryptionConfig{
+			DataClasses:        DataClasses(),
+			RotationPeriodDays: 365,
+			PendingWindowDays:  30,
+			Migration:          EncryptionMigrationConfig{NameSuffix: "cmk", RestoreFromSnapshots: true},
+		}
+	default:
+		// 開発環境はキーの費用を抑えるためAWS管理キーを使用
+		return EncryptionConfig{}
+	}
+}
+
+// UsesCustomerManagedKey データ分類にCMKを使用するか
+func (c EncryptionConfig) UsesCustomerManagedKey(dataClass string) bool {
+	return slices.Contains(c.DataClasses, dataClass)
+}
+
+// ReplacesResources CMKの使用でリソースが置換されるデータ分類を含むか
+func (c EncryptionConfig) ReplacesResources() bool {
+	return slices.ContainsFunc(replacedDataClasses, c.replacesResource)
+}
+
+// replacesResource データ分類のリソースがCMKの使用で置換されるか
+func (c EncryptionConfig) replacesResource(dataClass string) bool {
+	return c.UsesCustomerManagedKey(dataClass) && slices.Contains(replacedDataClasses, dataClass)
+}
+
+// ResourceName データ分類のリソースの物理名（CMKの使用で置換される場合は移行設定の接尾辞を付与）
+func (c EncryptionConfig) ResourceName(dataClass string, name string) string {
+	if !c.replacesResource(dataClass) {
+		return name
+	}
+	return name + "-" + c.Migration.NameSuffix
+}
+
+// RestoresFromSnapshot データ分類の新しいリソースを移行前のスナップショットから復元するか
+func (c EncryptionConfig) RestoresFromSnapshot(dataClass string) bool {
+	return c.replacesResource(dataClass) && c.Migration.RestoreFromSnapshots
+}
+
+// MigrationSnapshotName 移行前に既存のリソースから取得するスナップショットの名前
+func (c EncryptionConfig) MigrationSnapshotName(name string) string {
+	return name + "-before-" + c.Migration.NameSuffix
+}
+
+// RotationPeriod 自動ローテーションの間隔（日）
+func (c EncryptionConfig) RotationPeriod() int {
+	if c.RotationPeriodDays == 0 {
+		return defaultKeyRotation
+	}
+	return c.RotationPeriodDays
+}
+
+// PendingWindow 削除待機期間（日）
+func (c EncryptionConfig) PendingWindow() int {
+	if c.PendingWindowDays == 0 {
+		return defaultPendingWindow
+	}
+	return c.PendingWindowDays
+}
+
+// ValidateEncryptionConfig 暗号化設定の検証
+func ValidateEncryptionConfig(c EncryptionConfig) error {
+	seen := map[string]bool{}
+	for _, dataClass := range c.DataClasses {
+		if !slices.Contains(DataClasses(), dataClass) {
+			return fmt.Errorf("unknown data class: %s", dataClass)
+		}
+		if seen[dataClass] {
+			return fmt.Errorf("duplicate data class: %s", dataClass)
+		}
+		seen[dataClass] = true
+	}
+
+	// 置換されるリソースは新しい物理名で作成する
+	for _, dataClass := range replacedDataClasses {
+		if c.UsesCustomerManagedKey(dataClass) && c.Migration.NameSuffix == "" {
+			return fmt.Errorf("customer managed key for %s replaces the existing resource: set a migration name suffix", dataClass)
+		}
+	}
+	if c.Migration.NameSuffix != "" && !migrationNameSuffixPattern.MatchString(c.Migration.NameSuffix) {
+		return fmt.Errorf("invalid migration name suffix: %q", c.Migration.NameSuffix)
+	}
+
+	if rotation := c.RotationPeriod(); rotation < KeyRotationMinDays || rotation > KeyRotationMaxDays {
+		return fmt.Errorf("key rotation period must be between %d and %d days: %d", KeyRotationMinDays, KeyRotationMaxDays, rotation)
+	}
+	if window := c.PendingWindow(); window < KeyPendingWindowMin || window > KeyPendingWindowMax {
+		return fmt.Errorf("key pending window must be between %d and %d days: %d", KeyPendingWindowMin, KeyPendingWindowMax, window)
+	}
+
+	return nil
+}
@@ -15,6 +15,9 @@ type EnvironmentConfig struct {
 	// セキュリティ設定
 	AllowSSHAccess  bool
 	RestrictedCIDRs []string
+	ALBExposure     string // public, allowlist, cloudfront, https-only
+	ALBCertificate  string // ALBのHTTPSリスナーに使用するACM証明書ARN（https-onlyの場合は必須）
+	RestrictEgress  bool   // セキュリティグループのEgressを依存関係に限定
 
 	// タグ設定
 	Tags map[string]string
@@ -39,6 +42,15 @@ type ECSConfig struct {
 	EnableFargateSpot      bool // Fargate Spot使用
 }
 
+// ContainerPortConfig コンテナのポートマッピング設定
+type ContainerPortConfig struct {
+	ContainerName   string
+	ContainerPort   int
+	Protocol        string // tcp, udp
+	LoadBalanced    bool   // ALBのターゲットグループに登録するか
+	HealthCheckPort int    // 0の場合はContainerPortでヘルスチェック
+}
+
 // GetEnvironmentConfig 環境名から設定を取得
 func GetEnvironmentConfig(env string) (*EnvironmentConfig, error) {
 	configs := map[string]*EnvironmentConfig{
@@ -50,6 +62,7 @@ func GetEnvironmentConfig(env string) (*EnvironmentConfig, error) {
 			EnableVPCFlowLogs: false,
 			AllowSSHAccess:    true,
 			RestrictedCIDRs:   []string{"10.0.0.0/8"}, // 開発環境では内部ネットワークのみ
+			ALBExposure:       ALBExposureAllowlist,
 			Tags: map[string]string{
 				"Environment": "development",
 				"Project":     "PracticeService",
@@ -65,11 +78,13 @@ func GetEnvironmentConfig(env string) (*EnvironmentConfig, error) {
 			EnableVPCFlowLogs: true,
 			AllowSSHAccess:    false,
 			RestrictedCIDRs:   []string{"10.1.0.0/16"},
+			ALBExposure:       ALBExposureAllowlist,
 			Tags: map[string]string{
 				"Environment": "staging",
 				"Project":     "PracticeService",
 				"Owner":       "DevOpsTeam",
 				"CostCenter":  "Testing",
+				"Backup":      "Required",
 			},
 		},
 		"prod": {
@@ -80,6 +95,7 @@ func GetEnvironmentConfig(env string) (*EnvironmentConfig, error) {
 			EnableVPCFlowLogs: true,
 			AllowSSHAccess:    false,
 			RestrictedCIDRs:   []string{"10.2.0.0/16"},
+			ALBExposure:       ALBExposurePublic, // 本番環境はインターネット公開
 			Tags: map[string]string{
 				"Environment": "production",
 				"Project":     "PracticeService",
@@ -159,6 +175,44 @@ func GetECSConfig(environment string) *ECSConfig {
 	}
 }
 
+// GetContainerPortConfigs タスク定義のポートマッピング設定を取得
+func GetContainerPortConfigs(environment string) []ContainerPortConfig {
+	// 全環境共通：Nginxサイドカーのみ外部公開（PHP-FPMはタスク内通信）
+	return []ContainerPortConfig{
+		{
+			ContainerName: "nginx-web",
+			ContainerPort: 80,
+			Protocol:      "tcp",
+			LoadBalanced:  true,
+		},
+	}
+}
+
+// GetALBTargetPorts ALBからECSタスクへの通信が必要なポート一覧を取得（トラフィック＋ヘルスチェック）
+func GetALBTargetPorts(ports []ContainerPortConfig) []int {
+	seen := make(map[int]bool)
+	targetPorts := []int{}
+
+	add := func(port int) {
+		if !seen[port] {
+			seen[port] = true
+			targetPorts = append(targetPorts, port)
+		}
+	}
+
+	for _, p := range ports {
+		if !p.LoadBalanced {
+			continue
+		}
+		add(p.ContainerPort)
+		if p.HealthCheckPort != 0 {
+			add(p.HealthCheckPort)
+		}
+	}
+
+	return targetPorts
+}
+
 // ValidateEnvironment 環境名が有効かチェック
 func ValidateEnvironment(env string) bool {
 	validEnvs := []string{"dev", "staging", "prod"}
@@ -0,0 +1,154 @@
+package config
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// EFSのパフォーマンスモード・スループットモード
+const (
+	FileSystemPerformanceModeGeneralPurpose = "generalPurpose"
+	FileSystemPerformanceModeMaxIO          = "maxIO"
+	FileSystemThroughputModeBursting        = "bursting"
+	FileSystemThroughputModeElastic         = "elastic"
+)
+
+// FileSystemPort EFSのマウントターゲットのポート（NFS）
+const FileSystemPort = 2049
+
+// FileSystemTransitionToIADays 低頻度アクセスクラスへの移行で指定できる日数
+var FileSystemTransitionToIADays = []int{1, 7, 14, 30, 60, 90, 180, 270, 365}
+
+// FileSystemConfig タスク間で共有するEFSファイルシステムの設定
+type FileSystemConfig struct {
+	Enabled         bool
+	PerformanceMode string
+	ThroughputMode  string
+
+	// 最終アクセスから低頻度アクセスクラスへ移行するまでの日数（0の場合は移行しない）
+	TransitionToIADays int
+
+	// アプリケーションのパスごとのアクセスポイント
+	AccessPoints []FileSystemAccessPointConfig
+}
+
+// FileSystemAccessPointConfig アクセスポイントとコンテナへのマウント設定
+type FileSystemAccessPointConfig struct {
+	Name          string // uploads, sessions（ボリューム名・リソースIDに使用）
+	Path          string // ファイルシステム上のルートディレクトリ
+	ContainerPath string // コンテナのマウント先
+
+	// アクセスポイントで強制するPOSIXユーザーとルートディレクトリ作成時のパーミッション
+	UID         int
+	GID         int
+	Permissions string
+
+	// trueの場合はnginx-webにも読み取り専用でマウント
+	WebReadOnly bool
+}
+
+// fileSystemAccessPoints 全環境共通のアクセスポイント（php-fpmのwww-dataで読み書き）
+func fileSystemAccessPoints() []FileSystemAccessPointConfig {
+	return []FileSystemAccessPointConfig{
+		// アップロードファイルはnginxから直接配信
+		{Name: "uploads", Path: "/uploads", ContainerPath: "/var/www/html/storage/app/public", UID: 82, GID: 82, Permissions: "755", WebReadOnly: true},
+		{Name: "sessions", Path: "/sessions", ContainerPath: "/var/www/html/storage/framework/sessions", UID: 82, GID: 82, Permissions: "700"},
+	}
+}
+
+// GetFileSystemConfig 環境別のEFS設定を取得
+func GetFileSystemConfig(environment string) FileSystemConfig {
+	switch environment {
+	case "staging":
+		return FileSystemConfig{
+			Enabled:            true,
+			PerformanceMode:    FileSystemPerformanceModeGeneralPurpose,
+			ThroughputMode:     FileSystemThroughputModeBursting,
+			TransitionToIADays: 30,
+			AccessPoints:       fileSystemAccessPoints(),
+		}
+	case "prod":
+		return FileSystemConfig{
+			Enabled:            true,
+			PerformanceMode:    FileSystemPerformanceModeGeneralPurpose,
+			ThroughputMode:     FileSystemThroughputModeElastic,
+			TransitionToIADays: 30,
+			AccessPoints:       fileSystemAccessPoints(),
+		}
+	default:
+		// 開発環境は単一タスクのためコンテナのローカルストレージを使用
+		return FileSystemConfig{}
+	}
+}
+
+// ValidateFileSystemConfig EFS設定の検証
+func ValidateFileSystemConfig(c FileSystemConfig) error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if !slices.Contains([]string{FileSystemPerformanceModeGeneralPurpose, FileSystemPerformanceModeMaxIO}, c.PerformanceMode) {
+		return fmt.Errorf("unsupported performance mode: %s", c.PerformanceMode)
+	}
+	if !slices.Contains([]string{FileSystemThroughputModeBursting, FileSystemThroughputModeElastic}, c.ThroughputMode) {
+		return fmt.Errorf("unsupported throughput mode: %s", c.ThroughputMode)
+	}
+	if c.ThroughputMode == FileSystemThroughputModeElastic && c.PerformanceMode == FileSystemPerformanceModeMaxIO {
+		return fmt.Errorf("elastic throughput is not supported with %s performance mode", c.PerformanceMode)
+	}
+	if c.TransitionToIADays != 0 && !slices.Contains(FileSystemTransitionToIADays, c.TransitionToIADays) {
+		return fmt.Errorf("transition to IA must be one of %v days: %d", FileSystemTransitionToIADays, c.TransitionToIADays)
+	}
+
+	if len(c.AccessPoints) == 0 {
+		return fmt.Errorf("file system requires at least one access point")
+	}
+	names := map[string]bool{}
+	paths := map[string]bool{}
+	containerPaths := map[string]bool{}
+	for _, ap := range c.AccessPoints {
+		if !regexp.MustCompile(`^[a-z][a-z0-9]*(-[a-z0-9]+)*$`).MatchString(ap.Name) {
+			return fmt.Errorf("invalid access point name: %q", ap.Name)
+		}
+		if names[ap.Name] {
+			return fmt.Errorf("duplicate access point: %s", ap.Name)
+		}
+		names[ap.Name] = true
+
+		if err := validateAbsolutePath(ap.Path); err != nil {
+			return fmt.Errorf("%s: path: %w", ap.Name, err)
+		}
+		if paths[ap.Path] {
+			return fmt.Errorf("%s: duplicate path: %s", ap.Name, ap.Path)
+		}
+		paths[ap.Path] = true
+
+		if err := validateAbsolutePath(ap.ContainerPath); err != nil {
+			return fmt.Errorf("%s: container path: %w", ap.Name, err)
+		}
+		if containerPaths[ap.ContainerPath] {
+			return fmt.Errorf("%s: duplicate container path: %s", ap.Name, ap.ContainerPath)
+		}
+		containerPaths[ap.ContainerPath] = true
+
+		if ap.UID < 0 || ap.GID < 0 {
+			return fmt.Errorf("%s: uid and gid must not be negative: %d, %d", ap.Name, ap.UID, ap.GID)
+		}
+		if !regexp.MustCompile(`^[0-7]{3,4}$`).MatchString(ap.Permissions) {
+			return fmt.Errorf("%s: permissions must be octal: %q", ap.Name, ap.Permissions)
+		}
+	}
+
+	return nil
+}
+
+// validateAbsolutePath ルート以外の正規化された絶対パスか検証
+func validateAbsolutePath(p string) error {
+	if !strings.HasPrefix(p, "/") || p == "/" || path.Clean(p) != p {
+		return fmt.Errorf("must be a clean absolute path other than /: %q", p)
+	}
+	return nil
+}
@@ -0,0 +1,309 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+)
+
+// ピア種別（ルールの送信元）
+const (
+	PeerTypeTier       = "tier"        // 他のティアのセキュリティグループ
+	PeerTypeCIDR       = "cidr"        // IPv4 CIDR
+	PeerTypeAnyIPv4    = "any-ipv4"    // 0.0.0.0/0
+	PeerTypePrefixList = "prefix-list" // マネージドプレフィックスリストID
+	PeerTypeCloudFront = "cloudfront"  // CloudFront origin-facingプレフィックスリスト（AWSマネージド）
+	PeerTypeAWSService = "aws-service" // AWSサービスのマネージドプレフィックスリスト（s3 など）
+)
+
+// ルールの方向
+const (
+	DirectionIngress = "ingress" // Tierへの受信（既定）
+	DirectionEgress  = "egress"  // Tierからの送信
+)
+
+// ALBの公開ポリシー
+const (
+	ALBExposurePublic     = "public"     // HTTP/HTTPSをインターネットに公開
+	ALBExposureAllowlist  = "allowlist"  // RestrictedCIDRsからのみ許可
+	ALBExposureCloudFront = "cloudfront" // CloudFrontからのみ許可
+	ALBExposureHTTPSOnly  = "https-only" // HTTPSのみインターネットに公開
+)
+
+// CloudFrontOriginFacingPrefixList CloudFrontのorigin-facingマネージドプレフィックスリスト名
+const CloudFrontOriginFacingPrefixList = "com.amazonaws.global.cloudfront.origin-facing"
+
+// SecurityTierConfig セキュリティグループのティア定義
+type SecurityTierConfig struct {
+	Name             string `json:"name"`        // ALB, ECS, RDS など（リソースIDとSG名に使用）
+	Description      string `json:"description"` // GroupDescription
+	Component        string `json:"component"`   // Componentタグ
+	AllowAllOutbound bool   `json:"allowAllOutbound"`
+}
+
+// SecurityRuleConfig ティア間のルール定義
+type SecurityRuleConfig struct {
+	Tier        string `json:"tier"`                // Ingressの場合は宛先、Egressの場合は送信元のティア
+	Direction   string `json:"direction,omitempty"` // ingress（既定）, egress
+	PeerType    string `json:"peerType"`            // tier, cidr, any-ipv4, prefix-list, cloudfront, aws-service
+	Peer        string `json:"peer"`                // ティア名 / CIDR / プレフィックスリストID / サービス名（any-ipv4, cloudfrontの場合は不要）
+	Protocol    string `json:"protocol"`            // tcp, udp, icmp, all
+	FromPort    int    `json:"fromPort"`
+	ToPort      int    `json:"toPort"`
+	Description string `json:"description"`
+}
+
+// SecurityMatrixConfig セキュリティグループとルールの宣言的定義
+type SecurityMatrixConfig struct {
+	Tiers []SecurityTierConfig `json:"tiers"`
+	Rules []SecurityRuleConfig `json:"rules"`
+
+	// trueの場合、全ティアのAllowAllOutboundを無効化し、
+	// ティア間Ingressと対になるEgressと明示したEgressルールのみを許可
+	RestrictEgress bool `json:"restrictEgress"`
+}
+
+// SecurityMatrixOptions セキュリティグループ定義の生成オプション
+type SecurityMatrixOptions struct {
+	ALBExposure    string
+	AllowedCIDRs   []string
+	RestrictEgress bool
+}
+
+// IsEgress ルールがEgressかどうか
+func (r SecurityRuleConfig) IsEgress() bool {
+	return r.Direction == DirectionEgress
+}
+
+// GetSecurityMatrixConfig 環境別のセキュリティグループ定義を取得
+func GetSecurityMatrixConfig(environment string) *SecurityMatrixConfig {
+	opts := SecurityMatrixOptions{ALBExposure: ALBExposurePublic}
+	if envConfig, err := GetEnvironmentConfig(environment); err == nil {
+		opts.ALBExposure = envConfig.ALBExposure
+		opts.AllowedCIDRs = envConfig.RestrictedCIDRs
+		opts.RestrictEgress = envConfig.RestrictEgress
+	}
+	return BuildSecurityMatrixConfig(environment, opts)
+}
+
+// BuildSecurityMatrixConfig オプションを指定してセキュリティグループ定義を作成
+func BuildSecurityMatrixConfig(environment string, opts SecurityMatrixOptions) *SecurityMatrixConfig {
+	// ALBのIngressは公開ポリシーから生成
+	// ECS → RDS / Cacheの許可はリソースを所有するStorageStackがServiceSecurityGroupsのConnections経由で作成
+	rules := GetALBIngressRules(opts.ALBExposure, opts.AllowedCIDRs)
+
+	// ALB → ECSのルールはタスク定義のポートマッピング・ヘルスチェックポートから生成
+	for _, port := range GetALBTargetPorts(GetContainerPortConfigs(environment)) {
+		rules = append(rules, SecurityRuleConfig{
+			Tier: "ECS", PeerType: PeerTypeTier, Peer: "ALB", Protocol: "tcp", FromPort: port, ToPort: port,
+			Description: fmt.Sprintf("Allow container port %d from ALB", port),
+		})
+	}
+
+	// 全環境共通の3層構成（ALB → ECS → RDS / Cache）
+	tiers := []SecurityTierConfig{
+		{Name: "ALB", Description: "Security group for ALB", Component: "LoadBalancer", AllowAllOutbound: true},
+		{Name: "ECS", Description: "Security group for ECS tasks", Component: "Application", AllowAllOutbound: true},
+		{Name: "RDS", Description: "Security group for RDS database", Component: "Database", AllowAllOutbound: false}, // データベースは外部通信不要
+		{Name: "Cache", Description: "Security group for ElastiCache", Component: "Cache", AllowAllOutbound: false},
+	}
+
+	// Egress制限時はECSからAWSサービスへの通信をVPCエンドポイント経由に限定
+	if opts.RestrictEgress {
+		tiers = append(tiers, SecurityTierConfig{
+			Name: "Endpoints", Description: "Security group for VPC interface endpoints", Component: "Network", AllowAllOutbound: false,
+		})
+		rules = append(rules,
+			SecurityRuleConfig{Tier: "Endpoints", PeerType: PeerTypeTier, Peer: "ECS", Protocol: "tcp", FromPort: 443, ToPort: 443, Description: "Allow HTTPS from ECS to VPC endpoints"},
+			SecurityRuleConfig{Tier: "ECS", Direction: DirectionEgress, PeerType: PeerTypeAWSService, Peer: "s3", Protocol: "tcp", FromPort: 443, ToPort: 443, Description: "Allow HTTPS to S3 (ECR image layers)"},
+		)
+	}
+
+	return &SecurityMatrixConfig{
+		Tiers:          tiers,
+		Rules:          rules,
+		RestrictEgress: opts.RestrictEgress,
+	}
+}
+
+// GetALBIngressRules ALB公開ポリシーからIngressルールを作成
+func GetALBIngressRules(exposure string, allowedCIDRs []string) []SecurityRuleConfig {
+	switch exposure {
+	case ALBExposureAllowlist:
+		rules := []SecurityRuleConfig{}
+		for _, cidr := range allowedCIDRs {
+			rules = append(rules,
+				SecurityRuleConfig{Tier: "ALB", PeerType: PeerTypeCIDR, Peer: cidr, Protocol: "tcp", FromPort: 80, ToPort: 80, Description: "Allow HTTP traffic from " + cidr},
+				SecurityRuleConfig{Tier: "ALB", PeerType: PeerTypeCIDR, Peer: cidr, Protocol: "tcp", FromPort: 443, ToPort: 443, Description: "Allow HTTPS traffic from " + cidr},
+			)
+		}
+		return rules
+	case ALBExposureCloudFront:
+		// プレフィックスリストはエントリ数分ルールクォータを消費するため、オリジン用のHTTPのみ許可
+		return []SecurityRuleConfig{
+			{Tier: "ALB", PeerType: PeerTypeCloudFront, Protocol: "tcp", FromPort: 80, ToPort: 80, Description: "Allow HTTP traffic from CloudFront"},
+		}
+	case ALBExposureHTTPSOnly:
+		return []SecurityRuleConfig{
+			{Tier: "ALB", PeerType: PeerTypeAnyIPv4, Protocol: "tcp", FromPort: 443, ToPort: 443, Description: "Allow HTTPS traffic from internet"},
+		}
+	default:
+		return []SecurityRuleConfig{
+			{Tier: "ALB", PeerType: PeerTypeAnyIPv4, Protocol: "tcp", FromPort: 80, ToPort: 80, Description: "Allow HTTP traffic from internet"},
+			{Tier: "ALB", PeerType: PeerTypeAnyIPv4, Protocol: "tcp", FromPort: 443, ToPort: 443, Description: "Allow HTTPS traffic from internet"},
+		}
+	}
+}
+
+// ValidateALBExposure ALB公開ポリシーの妥当性を検証
+func ValidateALBExposure(exposure string, allowedCIDRs []string, certificateArn string) error {
+	if certificateArn != "" && !regexp.MustCompile(`^arn:aws:acm:[a-z0-9-]+:\d{12}:certificate/[0-9a-f-]+$`).MatchString(certificateArn) {
+		return fmt.Errorf("invalid ALB certificate: %s", certificateArn)
+	}
+
+	switch exposure {
+	case ALBExposurePublic, ALBExposureCloudFront:
+		return nil
+	case ALBExposureHTTPSOnly:
+		// 443のみ開放するため、HTTPSリスナーの証明書が必要
+		if certificateArn == "" {
+			return fmt.Errorf("ALB exposure %s requires an ALB certificate", exposure)
+		}
+		return nil
+	case ALBExposureAllowlist:
+		if len(allowedCIDRs) == 0 {
+			return fmt.Errorf("ALB exposure %s requires at least one restricted CIDR", exposure)
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid ALB exposure: %s", exposure)
+	}
+}
+
+// RedirectsALBHTTPToHTTPS HTTPSリスナーがある場合にHTTPをHTTPSへリダイレクトするか
+// CloudFrontはHTTPでオリジンに接続するため、cloudfrontの場合はリダイレクトしない
+func RedirectsALBHTTPToHTTPS(exposure string, certificateArn string) bool {
+	return certificateArn != "" && exposure != ALBExposureCloudFront
+}
+
+// LoadSecurityMatrixConfig JSONファイルからセキュリティグループ定義を読み込み
+func LoadSecurityMatrixConfig(path string) (*SecurityMatrixConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read security matrix file %s: %w", path, err)
+	}
+
+	var matrix SecurityMatrixConfig
+	if err := json.Unmarshal(data, &matrix); err != nil {
+		return nil, fmt.Errorf("failed to parse security matrix file %s: %w", path, err)
+	}
+
+	if err := ValidateSecurityMatrixConfig(&matrix); err != nil {
+		return nil, err
+	}
+
+	return &matrix, nil
+}
+
+// FindTier ティア名から定義を取得
+func (m *SecurityMatrixConfig) FindTier(name string) (*SecurityTierConfig, bool) {
+	for i := range m.Tiers {
+		if m.Tiers[i].Name == name {
+			return &m.Tiers[i], true
+		}
+	}
+	return nil, false
+}
+
+// TierNames 定義されている全ティア名（定義順）
+func (m *SecurityMatrixConfig) TierNames() []string {
+	names := make([]string, len(m.Tiers))
+	for i, tier := range m.Tiers {
+		names[i] = tier.Name
+	}
+	return names
+}
+
+// ValidateSecurityMatrixConfig セキュリティグループ定義の妥当性を検証
+func ValidateSecurityMatrixConfig(matrix *SecurityMatrixConfig) error {
+	if matrix == nil || len(matrix.Tiers) == 0 {
+		return fmt.Errorf("security matrix must define at least one tier")
+	}
+
+	seen := make(map[string]bool)
+	for _, tier := range matrix.Tiers {
+		if tier.Name == "" {
+			return fmt.Errorf("security tier name is required")
+		}
+		if seen[tier.Name] {
+			return fmt.Errorf("duplicate security tier: %s", tier.Name)
+		}
+		seen[tier.Name] = true
+	}
+
+	for i, rule := range matrix.Rules {
+		if !seen[rule.Tier] {
+			return fmt.Errorf("rule %d: unknown tier: %s", i, rule.Tier)
+		}
+
+		switch rule.Direction {
+		case "", DirectionIngress, DirectionEgress:
+		default:
+			return fmt.Errorf("rule %d: invalid direction: %s", i, rule.Direction)
+		}
+
+		switch rule.PeerType {
+		case PeerTypeTier:
+			if !seen[rule.Peer] {
+				return fmt.Errorf("rule %d: unknown peer tier: %s", i, rule.Peer)
+			}
+		case PeerTypeCIDR, PeerTypePrefixList, PeerTypeAWSService:
+			if rule.Peer == "" {
+				return fmt.Errorf("rule %d: %s peer is required", i, rule.PeerType)
+			}
+		case PeerTypeAnyIPv4, PeerTypeCloudFront:
+		default:
+			return fmt.Errorf("rule %d: invalid peer type: %s", i, rule.PeerType)
+		}
+
+		switch rule.Protocol {
+		case "tcp", "udp":
+			if rule.FromPort < 0 || rule.ToPort > 65535 || rule.FromPort > rule.ToPort {
+				return fmt.Errorf("rule %d: invalid port range: %d-%d", i, rule.FromPort, rule.ToPort)
+			}
+		case "icmp", "all":
+		default:
+			return fmt.Errorf("rule %d: invalid protocol: %s", i, rule.Protocol)
+		}
+	}
+
+	return nil
+}
+
+// ValidateECSIngressRules ECSティアのルールがコンテナの待受ポートに対応しているか検証
+func ValidateECSIngressRules(matrix *SecurityMatrixConfig, ports []ContainerPortConfig) error {
+	listening := make(map[string]bool)
+	for _, p := range ports {
+		listening[fmt.Sprintf("%s/%d", p.Protocol, p.ContainerPort)] = true
+		if p.HealthCheckPort != 0 {
+			listening[fmt.Sprintf("%s/%d", p.Protocol, p.HealthCheckPort)] = true
+		}
+	}
+
+	for i, rule := range matrix.Rules {
+		if rule.Tier != "ECS" || rule.IsEgress() {
+			continue
+		}
+		if rule.Protocol != "tcp" && rule.Protocol != "udp" {
+			return fmt.Errorf("rule %d (%s): protocol %s does not correspond to a container port", i, rule.Description, rule.Protocol)
+		}
+		for port := rule.FromPort; port <= rule.ToPort; port++ {
+			if !listening[fmt.Sprintf("%s/%d", rule.Protocol, port)] {
+				return fmt.Errorf("rule %d (%s): port %s/%d does not correspond to a listening container port", i, rule.Description, rule.Protocol, port)
+			}
+		}
+	}
+
+	return nil
+}
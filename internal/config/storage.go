@@ -0,0 +1,1020 @@
+package config
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// DatabaseName クラスター作成時のデータベース名
+const DatabaseName = "service"
+
+// Auroraエンジンファミリー
+const (
+	DatabaseEngineAuroraMySQL      = "aurora-mysql"
+	DatabaseEngineAuroraPostgreSQL = "aurora-postgresql"
+)
+
+// Auroraクラスターの役割（Global Database）
+const (
+	DatabaseRolePrimary   = "primary"   // 書き込み可能なプライマリクラスター
+	DatabaseRoleSecondary = "secondary" // DRリージョンの読み取り専用セカンダリクラスター
+)
+
+// Auroraクラスターの初期データ（シード）
+const (
+	DatabaseSeedNone     = ""         // 空のクラスターを新規作成
+	DatabaseSeedSnapshot = "snapshot" // 指定したクラスタースナップショットから復元
+	DatabaseSeedClone    = "clone"    // 既存クラスターのコピーオンライトクローン（同一アカウント・リージョン）
+)
+
+// ElastiCacheエンジン
+const (
+	CacheEngineRedis  = "redis"
+	CacheEngineValkey = "valkey"
+)
+
+// ElastiCacheの認証方式
+const (
+	CacheAuthToken = "token" // AUTHトークン（defaultユーザーのパスワード）
+	CacheAuthRBAC  = "rbac"  // ユーザーグループ（読み書き・読み取り専用ユーザー）
+)
+
+// Aurora容量モード
+const (
+	DatabaseCapacityProvisioned = "provisioned" // Writer・Readerともにプロビジョンドインスタンス
+	DatabaseCapacityServerless  = "serverless"  // Writer・ReaderともにServerless v2
+	DatabaseCapacityMixed       = "mixed"       // プロビジョンドWriter + Serverless v2 Reader
+)
+
+// Serverless v2の容量範囲（ACU）
+const (
+	ServerlessV2MinACU = 0.5
+	ServerlessV2MaxACU = 256
+)
+
+// DatabaseEngineConfig Auroraのエンジンファミリー・バージョン（StorageStack・ApplicationStack共通）
+type DatabaseEngineConfig struct {
+	Engine        string // aurora-mysql, aurora-postgresql
+	EngineVersion string // Aurora MySQL: 3.08.0（2系は2.11.0以降）/ Aurora PostgreSQL: 16.4
+
+	// メジャーバージョンのインプレースアップグレードを許可（アップグレードを含むデプロイでのみ有効にすること）
+	AllowMajorVersionUpgrade bool
+}
+
+// DatabaseConfig Aurora固有の設定
+type DatabaseConfig struct {
+	Engine        string // aurora-mysql, aurora-postgresql
+	EngineVersion string // Aurora MySQL: 3.08.0（2系は2.11.0以降）/ Aurora PostgreSQL: 16.4
+	Port          int    // エンジンから決定（セキュリティグループの許可にも使用）
+	Capacity      DatabaseCapacityConfig
+	Credentials   DatabaseCredentialsConfig
+	Proxy         DatabaseProxyConfig
+	Parameters    DatabaseParametersConfig
+	Monitoring    DatabaseMonitoringConfig
+	Global        DatabaseGlobalConfig
+	Seed          DatabaseSeedConfig
+
+	// メジャーバージョンのインプレースアップグレードを許可（DatabaseEngineConfigから設定）
+	AllowMajorVersionUpgrade bool
+}
+
+// DatabaseSeedConfig クラスター作成時の初期データ（本番データからステージング環境を作成する場合など）
+type DatabaseSeedConfig struct {
+	Mode                    string // "", snapshot, clone
+	SnapshotIdentifier      string // snapshotの場合の復元元（スナップショットIDまたはARN）
+	SourceClusterIdentifier string // cloneの場合のクローン元クラスター
+	Masking                 DatabaseMaskingConfig
+}
+
+// DatabaseMaskingConfig 復元後に個人情報をマスキングするECSタスクの設定
+// デプロイはタスクが終了コード0で停止するまで待機し、アプリケーションユーザーのパスワードは
+// マスキングの成功後に設定するため、マスキング完了まではアプリケーションから接続できない
+type DatabaseMaskingConfig struct {
+	Enabled        bool
+	Image          string   // マスキング処理のコンテナイメージ
+	Command        []string // 未指定の場合はイメージのCMD
+	CPU            int
+	Memory         int
+	TimeoutMinutes int // タスクの終了を待機する上限（最大60分、未指定の場合は60分）
+}
+
+// DatabaseGlobalConfig Aurora Global Databaseの設定（DRリージョンにセカンダリクラスターを配置）
+type DatabaseGlobalConfig struct {
+	Enabled         bool
+	SecondaryRegion string
+
+	// セカンダリクラスターのReader数（0の場合はヘッドレス、昇格前にインスタンスを追加する）
+	SecondaryInstanceCount int
+}
+
+// DatabaseMonitoringConfig Auroraの監視・監査設定
+type DatabaseMonitoringConfig struct {
+	MonitoringIntervalSeconds int // 拡張モニタリングの取得間隔（0の場合は無効）
+
+	// Performance Insights
+	PerformanceInsights              bool
+	PerformanceInsightsRetentionDays int  // 7（無料枠）、31の倍数（最大713）または731
+	PerformanceInsightsCustomerKey   bool // trueの場合はカスタマー管理のKMSキーで暗号化
+
+	// Advanced Auditing（MySQL: server_audit_logging + auditログのエクスポート / PostgreSQL: pgaudit）
+	AuditLogging bool
+	AuditEvents  string // 未指定の場合はエンジン別の既定値
+
+	LogRetentionDays int // エクスポートしたログのCloudWatch Logs保持期間
+}
+
+// DatabaseProxyConfig RDS Proxyの設定（PHP-FPMの短命な接続をプールして接続数の枯渇を防ぐ）
+type DatabaseProxyConfig struct {
+	Enabled    bool
+	IAMAuth    bool // trueの場合、クライアントはIAM認証トークンで接続（falseの場合はシークレットのパスワード）
+	RequireTLS bool // IAM認証時は必須
+
+	// 接続プール設定
+	MaxConnectionsPercent     int // クラスターのmax_connectionsに対する上限（%）
+	MaxIdleConnectionsPercent int // アイドル接続として保持する上限（%）
+	BorrowTimeoutSeconds      int // プールから接続を取得するまでの待機時間
+	IdleClientTimeoutMinutes  int // クライアント接続のアイドルタイムアウト
+}
+
+// DatabaseCredentialsConfig Auroraの認証情報（StorageStackのSecrets Managerで一元管理）
+type DatabaseCredentialsConfig struct {
+	AdminUsername string // クラスターのマスターユーザー（運用・ローテーション用）
+	AppUsername   string // アプリケーション用の最小権限ユーザー
+	RotationDays  int    // 自動ローテーション間隔（0の場合は無効）
+
+	// アプリケーションユーザーに付与するテーブル権限（DDLはマイグレーションでマスターユーザーが実行）
+	AppPrivileges []string
+}
+
+// DatabaseCapacityConfig Auroraのインスタンス構成・容量設定
+type DatabaseCapacityConfig struct {
+	Mode          string  // provisioned, serverless, mixed
+	InstanceCount int     // Writer + Readerの合計
+	InstanceClass string  // プロビジョンドインスタンスのクラス（例: r6g.large）
+	MinACU        float64 // Serverless v2の最小容量
+	MaxACU        float64 // Serverless v2の最大容量
+
+	// trueの場合、Serverless v2 Readerを昇格ティア0-1に配置しWriterの容量に追従させる
+	// （フェイルオーバー時に容量不足にならない）。falseの場合は自身の負荷でスケール
+	ReadersScaleWithWriter bool
+}
+
+// CacheConfig ElastiCache固有の設定
+type CacheConfig struct {
+	Engine        string // redis, valkey
+	EngineVersion string // メジャー.マイナー（例: 7.1）、パラメータグループのファミリーにも使用
+	Port          int    // セキュリティグループのポートにも使用
+	NodeType      string
+	DataTiering   bool // r6gdノードでSSDにデータを階層化
+	Topology      CacheTopologyConfig
+
+	// カスタムパラメータグループに設定するパラメータ（cluster-enabledはTopologyから設定）
+	Parameters map[string]string
+
+	Auth CacheAuthConfig
+}
+
+// CacheTopologyConfig ElastiCacheのノード構成
+type CacheTopologyConfig struct {
+	ClusterMode bool // trueの場合はシャーディング（クラスターモード有効）
+
+	// クラスターモード有効時
+	NumShards        int
+	ReplicasPerShard int
+
+	// クラスターモード無効時（プライマリ + レプリカのノード数）
+	NumCacheClusters int
+}
+
+// Replicas シャード（ノードグループ）あたりのレプリカ数
+func (c CacheTopologyConfig) Replicas() int {
+	if c.ClusterMode {
+		return c.ReplicasPerShard
+	}
+	return c.NumCacheClusters - 1
+}
+
+// ParameterGroupFamily エンジン・メジャーバージョンに対応するパラメータグループのファミリー
+func (c *CacheConfig) ParameterGroupFamily() string {
+	major, _, _ := strings.Cut(c.EngineVersion, ".")
+	if c.Engine == CacheEngineRedis && major == "6" {
+		return "redis6.x"
+	}
+	return c.Engine + major
+}
+
+// ClusterParameters パラメータグループに設定するパラメータ（クラスターモードの設定を含む）
+func (c *CacheConfig) ClusterParameters() map[string]string {
+	params := maps.Clone(c.Parameters)
+	if params == nil {
+		params = map[string]string{}
+	}
+	if c.Topology.ClusterMode {
+		params["cluster-enabled"] = "yes"
+	} else {
+		params["cluster-enabled"] = "no"
+	}
+	return params
+}
+
+// EngineName エンジンの表示名（出力・セキュリティグループルールの説明に使用）
+func (c *CacheConfig) EngineName() string {
+	if c.Engine == CacheEngineValkey {
+		return "Valkey"
+	}
+	return "Redis"
+}
+
+// SetEngine エンジンとバージョンを設定（バージョン未指定の場合はエンジンの既定バージョン）
+func (c *CacheConfig) SetEngine(engine string, version string) {
+	c.Engine = engine
+	c.EngineVersion = version
+	if version == "" {
+		c.EngineVersion = defaultCacheEngineVersion(engine)
+	}
+}
+
+// defaultCacheEngineVersion エンジン別の既定バージョン
+func defaultCacheEngineVersion(engine string) string {
+	if engine == CacheEngineValkey {
+		return "8.0"
+	}
+	return "7.1"
+}
+
+// CacheAuthConfig ElastiCacheの認証設定（認証情報はStorageStackのSecrets Managerで管理）
+type CacheAuthConfig struct {
+	Mode              string // token, rbac
+	ReadWriteUsername string // rbacのみ（アプリケーションが使用）
+	ReadOnlyUsername  string // rbacのみ（参照系のバッチ・調査用）
+
+	// パスワードのローテーション間隔（0の場合はローテーションしない、rbacのみ対応）
+	// 起動済みのタスクが接続を維持できるよう、直前のパスワードは次回のローテーションまで有効
+	RotationDays int
+}
+
+// GetDatabaseConfig 環境別のAurora設定を取得
+func GetDatabaseConfig(environment string) *DatabaseConfig {
+	engine := GetDatabaseEngineConfig(environment)
+	dbConfig := NewDatabaseConfig(engine.Engine, engine.EngineVersion, GetDatabaseCapacityConfig(environment))
+	dbConfig.AllowMajorVersionUpgrade = engine.AllowMajorVersionUpgrade
+	dbConfig.Credentials = GetDatabaseCredentialsConfig(environment)
+	dbConfig.Proxy = GetDatabaseProxyConfig(environment)
+	dbConfig.Parameters = GetDatabaseParametersConfig(environment, dbConfig.Engine, dbConfig.EngineVersion)
+	dbConfig.Monitoring = GetDatabaseMonitoringConfig(environment)
+	dbConfig.Global = GetDatabaseGlobalConfig(environment)
+	dbConfig.Seed = GetDatabaseSeedConfig(environment)
+	return dbConfig
+}
+
+// GetDatabaseEngineConfig 環境別のAuroraエンジン設定を取得
+// 既存の開発・ステージング・本番環境はAurora MySQL 5.7.12（2系）で構築済みのため、
+// 開発・ステージング環境で3系へのメジャーアップグレードを明示的に許可する（手順はStackのAuroraMajorVersionUpgradeRunbook出力を参照）
+func GetDatabaseEngineConfig(environment string) DatabaseEngineConfig {
+	switch environment {
+	case "dev", "staging":
+		// 本番環境より先にアップグレードし、アプリケーションの互換性を確認
+		return DatabaseEngineConfig{
+			Engine:                   DatabaseEngineAuroraMySQL,
+			EngineVersion:            "3.08.0",
+			AllowMajorVersionUpgrade: true,
+		}
+	case "prod":
+		// ステージング環境での確認まで延長サポート対象の2系に留める
+		// Global Databaseのメンバーはクラスター単位のインプレースアップグレードができないため、
+		// アップグレードはGlobal Database単位で行う（AllowMajorVersionUpgradeとGlobal Databaseは併用不可）
+		return DatabaseEngineConfig{
+			Engine:        DatabaseEngineAuroraMySQL,
+			EngineVersion: "2.11.4",
+		}
+	default:
+		return DatabaseEngineConfig{
+			Engine:        DatabaseEngineAuroraMySQL,
+			EngineVersion: "3.08.0",
+		}
+	}
+}
+
+// GetDatabaseSeedConfig 環境別のAurora初期データ設定を取得
+// 全環境とも新規作成（本番データから作成する場合はStorageStackPropsのDatabaseSeedでModeと復元元・マスキングを指定）
+func GetDatabaseSeedConfig(environment string) DatabaseSeedConfig {
+	return DatabaseSeedConfig{Mode: DatabaseSeedNone}
+}
+
+// GetDatabaseGlobalConfig 環境別のAurora Global Database設定を取得
+func GetDatabaseGlobalConfig(environment string) DatabaseGlobalConfig {
+	switch environment {
+	case "prod":
+		// 本番環境は大阪リージョンにDR用のセカンダリクラスターを配置
+		return DatabaseGlobalConfig{
+			Enabled:                true,
+			SecondaryRegion:        "ap-northeast-3",
+			SecondaryInstanceCount: 1,
+		}
+	default:
+		return DatabaseGlobalConfig{Enabled: false}
+	}
+}
+
+// GetDatabaseMonitoringConfig 環境別のAurora監視・監査設定を取得
+func GetDatabaseMonitoringConfig(environment string) DatabaseMonitoringConfig {
+	switch environment {
+	case "staging":
+		return DatabaseMonitoringConfig{
+			MonitoringIntervalSeconds:        60,
+			PerformanceInsights:              true,
+			PerformanceInsightsRetentionDays: 7,
+			LogRetentionDays:                 30,
+		}
+	case "prod":
+		// 本番環境は監査ログを取得し、Performance Insightsを長期保持
+		return DatabaseMonitoringConfig{
+			MonitoringIntervalSeconds:        30,
+			PerformanceInsights:              true,
+			PerformanceInsightsRetentionDays: 93,
+			PerformanceInsightsCustomerKey:   true,
+			AuditLogging:                     true,
+			LogRetentionDays:                 365,
+		}
+	default:
+		return DatabaseMonitoringConfig{
+			MonitoringIntervalSeconds: 60,
+			LogRetentionDays:          7,
+		}
+	}
+}
+
+// GetDatabaseProxyConfig 環境別のRDS Proxy設定を取得
+func GetDatabaseProxyConfig(environment string) DatabaseProxyConfig {
+	switch environment {
+	case "staging", "prod":
+		// オートスケーリング時の接続バーストをプールで吸収
+		return DatabaseProxyConfig{
+			Enabled:                   true,
+			RequireTLS:                true,
+			MaxConnectionsPercent:     90,
+			MaxIdleConnectionsPercent: 50,
+			BorrowTimeoutSeconds:      120,
+			IdleClientTimeoutMinutes:  30,
+		}
+	default:
+		return DatabaseProxyConfig{Enabled: false} // 開発環境はクラスターに直接接続
+	}
+}
+
+// GetDatabaseCredentialsConfig 環境別のAurora認証情報設定を取得
+func GetDatabaseCredentialsConfig(environment string) DatabaseCredentialsConfig {
+	credentials := defaultDatabaseCredentials()
+	switch environment {
+	case "staging", "prod":
+		credentials.RotationDays = 30
+	default:
+		credentials.RotationDays = 0 // 開発環境はローテーションなし
+	}
+	return credentials
+}
+
+// SetEngine エンジンを変更（ポート・パラメータもエンジンの既定値に変更）
+func (c *DatabaseConfig) SetEngine(engine string, version string) {
+	c.Engine = engine
+	c.EngineVersion = version
+	c.Port = GetDatabasePort(engine)
+	c.Parameters = defaultDatabaseParameters(engine, version)
+}
+
+// NewDatabaseConfig エンジンを指定してAurora設定を作成（ポートはエンジンから決定）
+func NewDatabaseConfig(engine string, version string, capacity DatabaseCapacityConfig) *DatabaseConfig {
+	return &DatabaseConfig{
+		Engine:        engine,
+		EngineVersion: version,
+		Port:          GetDatabasePort(engine),
+		Capacity:      capacity,
+		Credentials:   defaultDatabaseCredentials(),
+		Parameters:    defaultDatabaseParameters(engine, version),
+	}
+}
+
+// defaultDatabaseCredentials 既定のユーザー名（ローテーションなし）
+func defaultDatabaseCredentials() DatabaseCredentialsConfig {
+	return DatabaseCredentialsConfig{
+		AdminUsername: "admin",
+		AppUsername:   "app",
+		AppPrivileges: []string{"SELECT", "INSERT", "UPDATE", "DELETE"},
+	}
+}
+
+// GetDatabasePort エンジンの既定ポートを取得
+func GetDatabasePort(engine string) int {
+	if engine == DatabaseEngineAuroraPostgreSQL {
+		return 5432
+	}
+	return 3306
+}
+
+// IsPostgreSQL Aurora PostgreSQLかどうか
+func (c *DatabaseConfig) IsPostgreSQL() bool {
+	return c.Engine == DatabaseEngineAuroraPostgreSQL
+}
+
+// EngineName エンジンの表示名（出力の説明に使用）
+func (c *DatabaseConfig) EngineName() string {
+	if c.IsPostgreSQL() {
+		return "Aurora PostgreSQL"
+	}
+	return "Aurora MySQL"
+}
+
+// ProtocolName 接続プロトコル名（セキュリティグループルールの説明に使用）
+func (c *DatabaseConfig) ProtocolName() string {
+	if c.IsPostgreSQL() {
+		return "PostgreSQL"
+	}
+	return "MySQL"
+}
+
+// MajorVersion エンジンのメジャーバージョン（パラメータグループファミリーに使用）
+func (c *DatabaseConfig) MajorVersion() string {
+	if c.IsPostgreSQL() {
+		return strings.SplitN(c.EngineVersion, ".", 2)[0]
+	}
+	return mysqlCompatibleVersion(c.EngineVersion)
+}
+
+// FullEngineVersion CloudFormationに指定するエンジンバージョン
+func (c *DatabaseConfig) FullEngineVersion() string {
+	if c.IsPostgreSQL() {
+		return c.EngineVersion
+	}
+	return mysqlCompatibleVersion(c.EngineVersion) + ".mysql_aurora." + c.EngineVersion
+}
+
+// mysqlCompatibleVersion Aurora MySQLのバージョンに対応するMySQLのバージョン（2系は5.7、3系は8.0互換）
+func mysqlCompatibleVersion(version string) string {
+	if strings.HasPrefix(version, "2.") {
+		return "5.7"
+	}
+	return "8.0"
+}
+
+// LogExports CloudWatch Logsに出力するログ種別（PostgreSQLの監査ログはpostgresqlログに出力）
+func (c *DatabaseConfig) LogExports() []string {
+	if c.IsPostgreSQL() {
+		return []string{"postgresql"}
+	}
+	if c.Monitoring.AuditLogging {
+		return []string{"audit", "error", "general", "slowquery"}
+	}
+	return []string{"error", "general", "slowquery"}
+}
+
+// AuditEvents 監査対象のイベント
+func (c *DatabaseConfig) AuditEvents() string {
+	if c.Monitoring.AuditEvents != "" {
+		return c.Monitoring.AuditEvents
+	}
+	if c.IsPostgreSQL() {
+		return "ddl,role"
+	}
+	return "CONNECT,QUERY_DCL,QUERY_DDL"
+}
+
+// ClusterParameters クラスターパラメータグループの実効値（監査設定を含む）
+func (c *DatabaseConfig) ClusterParameters() map[string]string {
+	params := maps.Clone(c.Parameters.Cluster)
+	if params == nil {
+		params = map[string]string{}
+	}
+	if !c.Monitoring.AuditLogging {
+		return params
+	}
+
+	if c.IsPostgreSQL() {
+		params["shared_preload_libraries"] = "pgaudit"
+		params["pgaudit.log"] = c.AuditEvents()
+	} else {
+		params["server_audit_logging"] = "1"
+		params["server_audit_events"] = c.AuditEvents()
+	}
+	return params
+}
+
+// ConnectionName アプリケーションのDB_CONNECTION値
+func (c *DatabaseConfig) ConnectionName() string {
+	if c.IsPostgreSQL() {
+		return "pgsql"
+	}
+	return "mysql"
+}
+
+// ClientImage マスターユーザーで接続するタスクのDBクライアントイメージ（パブリックレジストリ）
+func (c *DatabaseConfig) ClientImage() string {
+	if c.IsPostgreSQL() {
+		return "postgres:16-alpine"
+	}
+	return "mysql:8.0"
+}
+
+// GetDatabaseCapacityConfig 環境別のAurora容量設定を取得
+func GetDatabaseCapacityConfig(environment string) DatabaseCapacityConfig {
+	switch environment {
+	case "dev":
+		// 開発環境は最小容量付近までスケールダウン
+		return DatabaseCapacityConfig{
+			Mode:          DatabaseCapacityServerless,
+			InstanceCount: 1,
+			MinACU:        0.5,
+			MaxACU:        2,
+		}
+	case "staging":
+		return DatabaseCapacityConfig{
+			Mode:                   DatabaseCapacityServerless,
+			InstanceCount:          2,
+			MinACU:                 0.5,
+			MaxACU:                 4,
+			ReadersScaleWithWriter: true,
+		}
+	case "prod":
+		// 本番環境はプロビジョンド容量を維持
+		return DatabaseCapacityConfig{
+			Mode:          DatabaseCapacityProvisioned,
+			InstanceCount: 3,
+			InstanceClass: "r5.large",
+		}
+	default:
+		return DatabaseCapacityConfig{
+			Mode:          DatabaseCapacityProvisioned,
+			InstanceCount: 1,
+			InstanceClass: "t3.medium",
+		}
+	}
+}
+
+// UsesServerlessV2 Serverless v2インスタンスを含むかどうか
+func (c DatabaseCapacityConfig) UsesServerlessV2() bool {
+	switch c.Mode {
+	case DatabaseCapacityServerless:
+		return true
+	case DatabaseCapacityMixed:
+		return c.InstanceCount > 1 // Readerが存在する場合のみ
+	default:
+		return false
+	}
+}
+
+// ValidateDatabaseCapacityConfig Aurora容量設定の検証
+func ValidateDatabaseCapacityConfig(c DatabaseCapacityConfig) error {
+	switch c.Mode {
+	case DatabaseCapacityProvisioned, DatabaseCapacityServerless, DatabaseCapacityMixed:
+	default:
+		return fmt.Errorf("invalid capacity mode: %s", c.Mode)
+	}
+
+	if c.InstanceCount < 1 {
+		return fmt.Errorf("instance count must be at least 1: %d", c.InstanceCount)
+	}
+
+	if c.Mode != DatabaseCapacityServerless && c.InstanceClass == "" {
+		return fmt.Errorf("instance class is required for %s mode", c.Mode)
+	}
+
+	if c.UsesServerlessV2() {
+		if c.MinACU < ServerlessV2MinACU || c.MaxACU > ServerlessV2MaxACU {
+			return fmt.Errorf("ACU range must be within %.1f-%d: %.1f-%.1f", ServerlessV2MinACU, ServerlessV2MaxACU, c.MinACU, c.MaxACU)
+		}
+		if c.MinACU > c.MaxACU {
+			return fmt.Errorf("min ACU %.1f exceeds max ACU %.1f", c.MinACU, c.MaxACU)
+		}
+	}
+
+	return nil
+}
+
+// ValidateDatabaseProxyConfig RDS Proxy設定の検証
+func ValidateDatabaseProxyConfig(c DatabaseProxyConfig) error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.IAMAuth && !c.RequireTLS {
+		return fmt.Errorf("IAM authentication requires TLS for RDS Proxy")
+	}
+	if c.MaxConnectionsPercent < 1 || c.MaxConnectionsPercent > 100 {
+		return fmt.Errorf("max connections percent must be within 1-100: %d", c.MaxConnectionsPercent)
+	}
+	if c.MaxIdleConnectionsPercent < 0 || c.MaxIdleConnectionsPercent > c.MaxConnectionsPercent {
+		return fmt.Errorf("max idle connections percent must be within 0-%d: %d", c.MaxConnectionsPercent, c.MaxIdleConnectionsPercent)
+	}
+	if c.BorrowTimeoutSeconds < 1 || c.BorrowTimeoutSeconds > 3600 {
+		return fmt.Errorf("borrow timeout must be within 1-3600 seconds: %d", c.BorrowTimeoutSeconds)
+	}
+	if c.IdleClientTimeoutMinutes < 1 || c.IdleClientTimeoutMinutes > 480 {
+		return fmt.Errorf("idle client timeout must be within 1-480 minutes: %d", c.IdleClientTimeoutMinutes)
+	}
+
+	return nil
+}
+
+// ValidateDatabaseGlobalConfig Aurora Global Database設定の検証
+func ValidateDatabaseGlobalConfig(c DatabaseGlobalConfig) error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if !regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`).MatchString(c.SecondaryRegion) {
+		return fmt.Errorf("invalid secondary region: %q", c.SecondaryRegion)
+	}
+	if c.SecondaryInstanceCount < 0 || c.SecondaryInstanceCount > 15 {
+		return fmt.Errorf("secondary instance count must be within 0-15: %d", c.SecondaryInstanceCount)
+	}
+
+	return nil
+}
+
+// ValidateDatabaseSeedConfig Aurora初期データ設定の検証
+func ValidateDatabaseSeedConfig(c DatabaseSeedConfig) error {
+	switch c.Mode {
+	case DatabaseSeedNone:
+		return nil // マスキングは復元・クローン時のみ実行
+	case DatabaseSeedSnapshot:
+		if c.SnapshotIdentifier == "" {
+			return fmt.Errorf("snapshot identifier is required for %s seed mode", c.Mode)
+		}
+	case DatabaseSeedClone:
+		if c.SourceClusterIdentifier == "" {
+			return fmt.Errorf("source cluster identifier is required for %s seed mode", c.Mode)
+		}
+	default:
+		return fmt.Errorf("invalid seed mode: %s", c.Mode)
+	}
+
+	if c.Masking.Enabled {
+		if c.Masking.Image == "" {
+			return fmt.Errorf("data masking image is required")
+		}
+		if c.Masking.CPU <= 0 || c.Masking.Memory <= 0 {
+			return fmt.Errorf("data masking task requires CPU and memory: %d/%d", c.Masking.CPU, c.Masking.Memory)
+		}
+		// CloudFormationのカスタムリソースは1時間以内に完了する必要がある
+		if c.Masking.TimeoutMinutes < 0 || c.Masking.TimeoutMinutes > 60 {
+			return fmt.Errorf("data masking timeout must be within 0-60 minutes (0 = default 60): %d", c.Masking.TimeoutMinutes)
+		}
+	}
+
+	return nil
+}
+
+// 拡張モニタリングの取得間隔（秒）
+var enhancedMonitoringIntervals = []int{0, 1, 5, 10, 15, 30, 60}
+
+// CloudWatch Logsで指定可能な保持期間（日）
+var cloudWatchLogsRetentionDays = []int{1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1096, 1827}
+
+// ValidateDatabaseMonitoringConfig Aurora監視・監査設定の検証
+func ValidateDatabaseMonitoringConfig(c DatabaseMonitoringConfig) error {
+	if !slices.Contains(enhancedMonitoringIntervals, c.MonitoringIntervalSeconds) {
+		return fmt.Errorf("invalid enhanced monitoring interval: %d (one of %v)", c.MonitoringIntervalSeconds, enhancedMonitoringIntervals)
+	}
+
+	if c.PerformanceInsights {
+		days := c.PerformanceInsightsRetentionDays
+		if days != 7 && days != 731 && (days%31 != 0 || days < 31 || days > 713) {
+			return fmt.Errorf("invalid Performance Insights retention: %d days (7, a multiple of 31 up to 713, or 731)", days)
+		}
+	} else if c.PerformanceInsightsCustomerKey {
+		return fmt.Errorf("Performance Insights customer key requires Performance Insights")
+	}
+
+	if !slices.Contains(cloudWatchLogsRetentionDays, c.LogRetentionDays) {
+		return fmt.Errorf("invalid log retention: %d days", c.LogRetentionDays)
+	}
+
+	return nil
+}
+
+// Auroraで利用可能なインスタンスクラスと、メジャーバージョンごとの対応最小バージョン
+// メジャーバージョンの記載がないクラスはそのメジャーバージョンでは利用できない（RDSユーザーガイドの対応表に基づく）
+var supportedAuroraInstanceClasses = map[string]map[string][]string{
+	DatabaseEngineAuroraMySQL: {
+		"t3":   {"2.11.0", "3.01.0"},
+		"t4g":  {"2.11.0", "3.01.0"},
+		"r5":   {"2.11.0", "3.01.0"},
+		"r6g":  {"2.11.0", "3.01.0"},
+		"r6i":  {"2.11.0", "3.02.0"},
+		"r7g":  {"2.12.0", "3.03.1"},
+		"r7i":  {"3.06.0"},
+		"r8g":  {"3.08.0"},
+		"x2g":  {"2.11.0", "3.01.0"},
+		"r6gd": {"3.04.0"}, // Optimized Reads
+	},
+	DatabaseEngineAuroraPostgreSQL: {
+		"t3":   {"13.0", "14.0", "15.0", "16.0"},
+		"t4g":  {"13.3", "14.3", "15.2", "16.1", "17.4"},
+		"r5":   {"13.0", "14.0", "15.0", "16.0", "17.4"},
+		"r6g":  {"13.0", "14.0", "15.0", "16.0", "17.4"},
+		"r6i":  {"13.7", "14.4", "15.2", "16.1", "17.4"},
+		"r7g":  {"13.10", "14.7", "15.2", "16.1", "17.4"},
+		"r7i":  {"13.13", "14.10", "15.5", "16.1", "17.4"},
+		"r8g":  {"13.15", "14.12", "15.7", "16.3", "17.4"},
+		"x2g":  {"13.0", "14.0", "15.0", "16.0", "17.4"},
+		"r6gd": {"14.9", "15.4", "16.1", "17.4"}, // Optimized Reads
+	},
+}
+
+// バースト可能クラスで利用可能なサイズ（medium以上のみ）
+var auroraBurstableInstanceSizes = []string{"medium", "large"}
+
+// PostgreSQLのメジャーバージョンごとのServerless v2対応最小マイナーバージョン
+var postgresServerlessV2MinMinor = map[int]int{13: 6, 14: 3, 15: 0, 16: 0, 17: 0}
+
+// parseEngineVersion エンジンバージョンを数値に分解（3.08.0 → [3 8 0]）
+func parseEngineVersion(version string) ([]int, error) {
+	parts := strings.Split(version, ".")
+	numbers := make([]int, len(parts))
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid engine version: %s", version)
+		}
+		numbers[i] = n
+	}
+	return numbers, nil
+}
+
+// supportsInstanceClass インスタンスクラスが同じメジャーバージョンの対応最小バージョン以降で利用可能かどうか
+func supportsInstanceClass(minimums []string, version []int) bool {
+	for _, minimum := range minimums {
+		numbers, err := parseEngineVersion(minimum)
+		if err == nil && numbers[0] == version[0] {
+			return slices.Compare(version, numbers) >= 0
+		}
+	}
+	return false
+}
+
+// databaseUsernamePattern ユーザー名（ユーザー作成のSQLで使用するため英小文字・数字・アンダースコアのみ）
+// マルチユーザーローテーションで付与される _clone を含めて16文字以内（MySQL 5.7の上限）
+var databaseUsernamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,9}$`)
+
+// databaseAppPrivileges アプリケーションユーザーに付与できるテーブル権限（MySQL・PostgreSQL共通）
+var databaseAppPrivileges = map[string]bool{"SELECT": true, "INSERT": true, "UPDATE": true, "DELETE": true}
+
+// validateDatabaseAppPrivileges アプリケーションユーザーの権限を検証（DDL・管理権限は付与しない）
+func validateDatabaseAppPrivileges(privileges []string) error {
+	if len(privileges) == 0 {
+		return fmt.Errorf("application user requires at least one privilege")
+	}
+	for _, privilege := range privileges {
+		if !databaseAppPrivileges[privilege] {
+			return fmt.Errorf("unsupported application user privilege: %s", privilege)
+		}
+	}
+	return nil
+}
+
+// ValidateDatabaseConfig エンジン・バージョン・インスタンスクラスの組み合わせを検証
+func ValidateDatabaseConfig(c *DatabaseConfig) error {
+	if err := ValidateDatabaseCapacityConfig(c.Capacity); err != nil {
+		return err
+	}
+
+	if c.Credentials.AdminUsername == "" || c.Credentials.AppUsername == "" {
+		return fmt.Errorf("admin and application usernames are required")
+	}
+	for _, username := range []string{c.Credentials.AdminUsername, c.Credentials.AppUsername} {
+		if !databaseUsernamePattern.MatchString(username) {
+			return fmt.Errorf("invalid database username: %s", username)
+		}
+	}
+	if c.Credentials.AdminUsername == c.Credentials.AppUsername {
+		return fmt.Errorf("application user must differ from admin user: %s", c.Credentials.AppUsername)
+	}
+	if c.Credentials.RotationDays < 0 || c.Credentials.RotationDays > 365 {
+		return fmt.Errorf("rotation days must be within 0-365: %d", c.Credentials.RotationDays)
+	}
+	if err := validateDatabaseAppPrivileges(c.Credentials.AppPrivileges); err != nil {
+		return err
+	}
+
+	if err := ValidateDatabaseProxyConfig(c.Proxy); err != nil {
+		return err
+	}
+	if err := ValidateDatabaseMonitoringConfig(c.Monitoring); err != nil {
+		return err
+	}
+	if err := ValidateDatabaseGlobalConfig(c.Global); err != nil {
+		return err
+	}
+	// Global Databaseのメンバーはクラスター単位でメジャーバージョンをアップグレードできない
+	if c.AllowMajorVersionUpgrade && c.Global.Enabled {
+		return fmt.Errorf("major version upgrade is not supported for Aurora Global Database members: upgrade the global cluster instead")
+	}
+	if err := ValidateDatabaseSeedConfig(c.Seed); err != nil {
+		return err
+	}
+
+	numbers, err := parseEngineVersion(c.EngineVersion)
+	if err != nil {
+		return err
+	}
+
+	switch c.Engine {
+	case DatabaseEngineAuroraMySQL:
+		// Aurora MySQL 2系（MySQL 5.7互換）は延長サポート対象の2.11以降のみ（3系へのアップグレードまでの移行用）
+		if len(numbers) != 3 || (numbers[0] != 2 && numbers[0] != 3) || (numbers[0] == 2 && numbers[1] < 11) {
+			return fmt.Errorf("unsupported Aurora MySQL version: %s (2.11.0 or later, or 3.x required)", c.EngineVersion)
+		}
+		if c.Capacity.UsesServerlessV2() && slices.Compare(numbers, []int{3, 2, 0}) < 0 {
+			return fmt.Errorf("Serverless v2 requires Aurora MySQL 3.02.0 or later: %s", c.EngineVersion)
+		}
+	case DatabaseEngineAuroraPostgreSQL:
+		if len(numbers) != 2 {
+			return fmt.Errorf("invalid Aurora PostgreSQL version: %s", c.EngineVersion)
+		}
+		minMinor, ok := postgresServerlessV2MinMinor[numbers[0]]
+		if !ok {
+			return fmt.Errorf("unsupported Aurora PostgreSQL version: %s (13-17 required)", c.EngineVersion)
+		}
+		if c.Capacity.UsesServerlessV2() && numbers[1] < minMinor {
+			return fmt.Errorf("Serverless v2 requires Aurora PostgreSQL %d.%d or later: %s", numbers[0], minMinor, c.EngineVersion)
+		}
+	default:
+		return fmt.Errorf("unsupported database engine: %s", c.Engine)
+	}
+
+	if err := ValidateDatabaseParametersConfig(c.Engine, c.Parameters); err != nil {
+		return err
+	}
+
+	// プロビジョンドインスタンスのクラス
+	if c.Capacity.Mode != DatabaseCapacityServerless {
+		if c.Global.Enabled && strings.HasPrefix(c.Capacity.InstanceClass, "t") {
+			return fmt.Errorf("instance class %s is not supported by Aurora Global Database (burstable classes are not allowed)", c.Capacity.InstanceClass)
+		}
+		class := strings.SplitN(c.Capacity.InstanceClass, ".", 2)
+		if len(class) != 2 {
+			return fmt.Errorf("invalid instance class: %s", c.Capacity.InstanceClass)
+		}
+		minimums, ok := supportedAuroraInstanceClasses[c.Engine][class[0]]
+		if !ok || !supportsInstanceClass(minimums, numbers) {
+			return fmt.Errorf("instance class %s is not supported by %s %s", c.Capacity.InstanceClass, c.Engine, c.EngineVersion)
+		}
+		if strings.HasPrefix(class[0], "t") && !slices.Contains(auroraBurstableInstanceSizes, class[1]) {
+			return fmt.Errorf("instance class %s is not supported by %s (burstable classes require medium or larger)", c.Capacity.InstanceClass, c.Engine)
+		}
+	}
+
+	return nil
+}
+
+// GetCacheConfig 環境別のElastiCache設定を取得
+func GetCacheConfig(environment string) *CacheConfig {
+	cacheConfig := &CacheConfig{
+		Engine:        CacheEngineRedis,
+		EngineVersion: defaultCacheEngineVersion(CacheEngineRedis),
+		Port:          6379,
+		Auth:          GetCacheAuthConfig(environment),
+	}
+
+	switch environment {
+	case "staging":
+		cacheConfig.NodeType = "cache.t3.small"
+		cacheConfig.Topology = CacheTopologyConfig{NumCacheClusters: 2}
+		cacheConfig.Parameters = map[string]string{"maxmemory-policy": "allkeys-lru"}
+	case "prod":
+		// セッションも保存するため、有効期限のないキーは退避しない
+		cacheConfig.NodeType = "cache.r6g.large"
+		cacheConfig.Topology = CacheTopologyConfig{NumCacheClusters: 3}
+		cacheConfig.Parameters = map[string]string{"maxmemory-policy": "volatile-lru"}
+	default:
+		cacheConfig.NodeType = "cache.t3.micro"
+		cacheConfig.Topology = CacheTopologyConfig{NumCacheClusters: 1}
+		cacheConfig.Parameters = map[string]string{"maxmemory-policy": "allkeys-lru"}
+	}
+
+	return cacheConfig
+}
+
+// GetCacheAuthConfig 環境別のElastiCache認証設定を取得
+func GetCacheAuthConfig(environment string) CacheAuthConfig {
+	switch environment {
+	case "staging":
+		return CacheAuthConfig{
+			Mode:              CacheAuthRBAC,
+			ReadWriteUsername: "app",
+			ReadOnlyUsername:  "app-readonly",
+		}
+	case "prod":
+		return CacheAuthConfig{
+			Mode:              CacheAuthRBAC,
+			ReadWriteUsername: "app",
+			ReadOnlyUsername:  "app-readonly",
+			RotationDays:      30,
+		}
+	default:
+		return CacheAuthConfig{
+			Mode: CacheAuthToken,
+		}
+	}
+}
+
+// ValidateCacheConfig ElastiCache設定の検証
+func ValidateCacheConfig(c *CacheConfig) error {
+	if c.Port < 1 || c.Port > 65535 {
+		return fmt.Errorf("invalid cache port: %d", c.Port)
+	}
+
+	// エンジンごとの最小バージョン（RBAC・AUTHトークンのローテーションはRedis 6以上）
+	minMajor := map[string]int{CacheEngineRedis: 6, CacheEngineValkey: 7}
+	required, ok := minMajor[c.Engine]
+	if !ok {
+		return fmt.Errorf("unsupported cache engine: %s", c.Engine)
+	}
+	if !regexp.MustCompile(`^\d+\.\d+$`).MatchString(c.EngineVersion) {
+		return fmt.Errorf("invalid cache engine version: %q", c.EngineVersion)
+	}
+	major, _ := strconv.Atoi(strings.Split(c.EngineVersion, ".")[0])
+	if major < required {
+		return fmt.Errorf("%s %s is not supported (requires %d.x or later)", c.Engine, c.EngineVersion, required)
+	}
+
+	if !strings.HasPrefix(c.NodeType, "cache.") {
+		return fmt.Errorf("invalid cache node type: %q", c.NodeType)
+	}
+	if c.DataTiering && !strings.HasPrefix(c.NodeType, "cache.r6gd.") {
+		return fmt.Errorf("data tiering requires r6gd node type: %s", c.NodeType)
+	}
+
+	if err := ValidateCacheTopologyConfig(c.Topology); err != nil {
+		return err
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(c.Parameters)) {
+		if name == "cluster-enabled" {
+			return fmt.Errorf("cache parameter cluster-enabled is set from topology")
+		}
+		if c.Parameters[name] == "" {
+			return fmt.Errorf("cache parameter %s has an empty value", name)
+		}
+	}
+
+	return ValidateCacheAuthConfig(c.Auth)
+}
+
+// ValidateCacheTopologyConfig ElastiCacheのノード構成の検証
+func ValidateCacheTopologyConfig(c CacheTopologyConfig) error {
+	if !c.ClusterMode {
+		if c.NumCacheClusters < 1 || c.NumCacheClusters > 6 {
+			return fmt.Errorf("number of cache clusters must be within 1-6: %d", c.NumCacheClusters)
+		}
+		return nil
+	}
+
+	if c.NumShards < 1 || c.NumShards > 500 {
+		return fmt.Errorf("number of shards must be within 1-500: %d", c.NumShards)
+	}
+	if c.ReplicasPerShard < 0 || c.ReplicasPerShard > 5 {
+		return fmt.Errorf("replicas per shard must be within 0-5: %d", c.ReplicasPerShard)
+	}
+	if c.NumCacheClusters != 0 {
+		return fmt.Errorf("number of cache clusters cannot be set in cluster mode")
+	}
+
+	return nil
+}
+
+// ValidateCacheAuthConfig ElastiCache認証設定の検証
+func ValidateCacheAuthConfig(c CacheAuthConfig) error {
+	switch c.Mode {
+	case CacheAuthToken:
+		// AUTHトークンの変更はレプリケーショングループの更新が必要なためSecrets Managerではローテーションしない
+		if c.RotationDays != 0 {
+			return fmt.Errorf("password rotation requires %s cache auth mode", CacheAuthRBAC)
+		}
+		return nil
+	case CacheAuthRBAC:
+	default:
+		return fmt.Errorf("invalid cache auth mode: %s", c.Mode)
+	}
+
+	// ユーザー名は英字で始まる英数字・ハイフン（ユーザーIDにも使用）
+	for _, name := range []string{c.ReadWriteUsername, c.ReadOnlyUsername} {
+		if !regexp.MustCompile(`^[a-z][a-z0-9-]{0,30}$`).MatchString(name) {
+			return fmt.Errorf("invalid cache username: %q", name)
+		}
+		if name == "default" {
+			return fmt.Errorf("cache username %q is reserved", name)
+		}
+	}
+	if c.ReadWriteUsername == c.ReadOnlyUsername {
+		return fmt.Errorf("read-write and read-only cache usernames must differ: %s", c.ReadWriteUsername)
+	}
+	if c.RotationDays < 0 || c.RotationDays > 365 {
+		return fmt.Errorf("cache password rotation must be within 0-365 days: %d", c.RotationDays)
+	}
+
+	return nil
+}
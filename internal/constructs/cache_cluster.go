@@ -0,0 +1,245 @@
+package constructs
+
+import (
+	"aws-ecs-fargate-go-cdk/internal/config"
+
+	"github.com/aws/aws-cdk-go/awscdk/v2"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awsec2"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awselasticache"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awskms"
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+)
+
+// CacheClusterProps CacheClusterのプロパティ
+type CacheClusterProps struct {
+	Vpc        awsec2.IVpc
+	VpcSubnets *awsec2.SubnetSelection // 未指定の場合はプライベートサブネット
+	Config     *config.CacheConfig
+
+	// レプリケーショングループID（サブネットグループ・セキュリティグループの名前にも使用）
+	ClusterName string
+	Description string
+
+	// 未指定の場合は専用のセキュリティグループを作成
+	SecurityGroups   []awsec2.ISecurityGroup
+	AllowAllOutbound bool // 専用のセキュリティグループを作成する場合のみ使用
+
+	// 認証（AUTHトークンまたはユーザーグループのいずれか）
+	AuthToken   *string
+	UserGroupId *string
+
+	// レプリカがある場合のみ有効
+	MultiAz bool
+
+	// 保存時の暗号化キー（未指定の場合はAWS管理キー）
+	KmsKey awskms.IKey
+
+	// 作成時に復元するスナップショット（未指定の場合は空のクラスター、変更すると置換される）
+	SnapshotName string
+
+	// バックアップ・メンテナンス設定
+	SnapshotRetentionDays      int
+	SnapshotWindow             string
+	PreferredMaintenanceWindow string
+}
+
+// CacheEndpoint ElastiCacheのエンドポイント
+type CacheEndpoint struct {
+	Hostname *string
+	Port     *string
+}
+
+// CacheCluster ElastiCacheのレプリケーショングループをまとめたL3コンストラクト
+type CacheCluster struct {
+	constructs.Construct
+
+	Config           *config.CacheConfig
+	ReplicationGroup awselasticache.CfnReplicationGroup
+	SubnetGroup      awselasticache.CfnSubnetGroup
+	ParameterGroup   awselasticache.CfnParameterGroup
+
+	connections awsec2.Connections
+}
+
+// NewCacheCluster 設定からElastiCacheのレプリケーショングループを作成
+func NewCacheCluster(scope constructs.Construct, id string, props *CacheClusterProps) *CacheCluster {
+	if err := config.ValidateCacheConfig(props.Config); err != nil {
+		panic("Invalid cache configuration: " + err.Error())
+	}
+
+	c := &CacheCluster{
+		Construct: constructs.NewConstruct(scope, jsii.String(id)),
+		Config:    props.Config,
+	}
+	cacheConfig := props.Config
+	topology := cacheConfig.Topology
+
+	// セキュリティグループ（Connectionsの既定ポートはキャッシュのポート）
+	securityGroups := props.SecurityGroups
+	if len(securityGroups) == 0 {
+		securityGroups = []awsec2.ISecurityGroup{createCacheSecurityGroup(c.Construct, props)}
+	}
+	c.connections = awsec2.NewConnections(&awsec2.ConnectionsProps{
+		SecurityGroups: &securityGroups,
+		DefaultPort:    awsec2.Port_Tcp(jsii.Number(cacheConfig.Port)),
+	})
+
+	// サブネットグループ（VPCのサブネット選択から作成）
+	vpcSubnets := props.VpcSubnets
+	if vpcSubnets == nil {
+		vpcSubnets = &awsec2.SubnetSelection{SubnetType: awsec2.SubnetType_PRIVATE_WITH_EGRESS}
+	}
+	c.SubnetGroup = awselasticache.NewCfnSubnetGroup(c.Construct, jsii.String("SubnetGroup"), &awselasticache.CfnSubnetGroupProps{
+		Description:          jsii.String("Subnet group for " + props.ClusterName),
+		SubnetIds:            props.Vpc.SelectSubnets(vpcSubnets).SubnetIds,
+		CacheSubnetGroupName: jsii.String(props.ClusterName + "-subnet-group"),
+	})
+
+	// パラメータグループ（クラスターモードの有無もパラメータで指定）
+	params := map[string]*string{}
+	for name, value := range cacheConfig.ClusterParameters() {
+		params[name] = jsii.String(value)
+	}
+	c.ParameterGroup = awselasticache.NewCfnParameterGroup(c.Construct, jsii.String("ParameterGroup"), &awselasticache.CfnParameterGroupProps{
+		CacheParameterGroupFamily: jsii.String(cacheConfig.ParameterGroupFamily()),
+		Description:               jsii.String("Parameter group for " + cacheConfig.Engine + " cluster " + props.ClusterName),
+		Properties:                &params,
+	})
+
+	securityGroupIds := []*string{}
+	for _, sg := range securityGroups {
+		securityGroupIds = append(securityGroupIds, sg.SecurityGroupId())
+	}
+
+	c.ReplicationGroup = awselasticache.NewCfnReplicationGroup(c.Construct, jsii.String("ReplicationGroup"), &awselasticache.CfnReplicationGroupProps{
+		ReplicationGroupDescription: jsii.String(props.Description),
+		ReplicationGroupId:          jsii.String(props.ClusterName),
+		Engine:                      jsii.String(cacheConfig.Engine),
+		EngineVersion:               jsii.String(cacheConfig.EngineVersion),
+		CacheNodeType:               jsii.String(cacheConfig.NodeType),
+		DataTieringEnabled:          jsii.Bool(cacheConfig.DataTiering),
+		CacheParameterGroupName:     c.ParameterGroup.Ref(),
+		CacheSubnetGroupName:        c.SubnetGroup.Ref(),
+
+		// ノード構成（クラスターモード有効時はシャード・レプリカ数、無効時はノード数）
+		NumCacheClusters: func() *float64 {
+			if topology.ClusterMode {
+				return nil
+			}
+			return jsii.Number(topology.NumCacheClusters)
+		}(),
+		NumNodeGroups: func() *float64 {
+			if !topology.ClusterMode {
+				return nil
+			}
+			return jsii.Number(topology.NumShards)
+		}(),
+		ReplicasPerNodeGroup: func() *float64 {
+			if !topology.ClusterMode {
+				return nil
+			}
+			return jsii.Number(topology.ReplicasPerShard)
+		}(),
+
+		// セキュリティ設定
+		AtRestEncryptionEnabled: jsii.Bool(true),
+		KmsKeyId: func() *string {
+			if props.KmsKey == nil {
+				return nil
+			}
+			return props.KmsKey.KeyArn()
+		}(),
+		TransitEncryptionEnabled: jsii.Bool(true), // AUTHトークン・ユーザーグループの前提
+		AuthToken:                props.AuthToken,
+		UserGroupIds: func() *[]*string {
+			if props.UserGroupId == nil {
+				return nil
+			}
+			return &[]*string{props.UserGroupId}
+		}(),
+		SecurityGroupIds: &securityGroupIds,
+		Port:             jsii.Number(cacheConfig.Port),
+
+		// 自動フェイルオーバー（クラスターモード有効時は必須）
+		AutomaticFailoverEnabled: jsii.Bool(topology.ClusterMode || topology.Replicas() > 0),
+		MultiAzEnabled:           jsii.Bool(props.MultiAz && topology.Replicas() > 0),
+
+		// バックアップ・メンテナンス設定
+		SnapshotName:               optionalString(props.SnapshotName),
+		SnapshotRetentionLimit:     jsii.Number(props.SnapshotRetentionDays),
+		SnapshotWindow:             optionalString(props.SnapshotWindow),
+		PreferredMaintenanceWindow: optionalString(props.PreferredMaintenanceWindow),
+	})
+
+	return c
+}
+
+// createCacheSecurityGroup キャッシュ専用のセキュリティグループを作成
+func createCacheSecurityGroup(scope constructs.Construct, props *CacheClusterProps) awsec2.ISecurityGroup {
+	sgName := props.ClusterName + "-sg"
+
+	sg := awsec2.NewSecurityGroup(scope, jsii.String("SecurityGroup"), &awsec2.SecurityGroupProps{
+		Vpc:               props.Vpc,
+		Description:       jsii.String("Security group for " + props.ClusterName),
+		SecurityGroupName: jsii.String(sgName),
+		AllowAllOutbound:  jsii.Bool(props.AllowAllOutbound),
+	})
+	awscdk.Tags_Of(sg).Add(jsii.String("Name"), jsii.String(sgName), nil)
+
+	return sg
+}
+
+// optionalString 空文字列の場合はnil（CloudFormationの既定値を使用）
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return jsii.String(value)
+}
+
+// Connections キャッシュへの接続を許可するためのConnections（IConnectableの実装）
+func (c *CacheCluster) Connections() awsec2.Connections {
+	return c.connections
+}
+
+// PrimaryEndpoint プライマリ（書き込み）エンドポイント（クラスターモード有効時はnil）
+func (c *CacheCluster) PrimaryEndpoint() *CacheEndpoint {
+	if c.Config.Topology.ClusterMode {
+		return nil
+	}
+	return &CacheEndpoint{
+		Hostname: c.ReplicationGroup.AttrPrimaryEndPointAddress(),
+		Port:     c.ReplicationGroup.AttrPrimaryEndPointPort(),
+	}
+}
+
+// ReaderEndpoint 読み取りエンドポイント（クラスターモード有効時はnil）
+func (c *CacheCluster) ReaderEndpoint() *CacheEndpoint {
+	if c.Config.Topology.ClusterMode {
+		return nil
+	}
+	return &CacheEndpoint{
+		Hostname: c.ReplicationGroup.AttrReaderEndPointAddress(),
+		Port:     c.ReplicationGroup.AttrReaderEndPointPort(),
+	}
+}
+
+// ConfigurationEndpoint 設定エンドポイント（クラスターモード無効時はnil）
+func (c *CacheCluster) ConfigurationEndpoint() *CacheEndpoint {
+	if !c.Config.Topology.ClusterMode {
+		return nil
+	}
+	return &CacheEndpoint{
+		Hostname: c.ReplicationGroup.AttrConfigurationEndPointAddress(),
+		Port:     c.ReplicationGroup.AttrConfigurationEndPointPort(),
+	}
+}
+
+// Endpoint アプリケーションが接続するエンドポイント（クラスターモード有効時は設定エンドポイント）
+func (c *CacheCluster) Endpoint() *CacheEndpoint {
+	if c.Config.Topology.ClusterMode {
+		return c.ConfigurationEndpoint()
+	}
+	return c.PrimaryEndpoint()
+}
@@ -0,0 +1,186 @@
+package constructs
+
+import (
+	"aws-ecs-fargate-go-cdk/internal/config"
+	"strings"
+
+	"github.com/aws/aws-cdk-go/awscdk/v2"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awsiam"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awskms"
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+)
+
+// DataKeysProps DataKeysのプロパティ
+type DataKeysProps struct {
+	Environment string
+	Config      *config.EncryptionConfig // 未指定の場合は環境設定を使用
+
+	// キーのエイリアス・説明に使用する名前（service-production など）
+	Name string
+}
+
+// DataKeysImportProps 既存のキーを参照する場合のプロパティ
+type DataKeysImportProps struct {
+	Environment string
+	Config      *config.EncryptionConfig // 未指定の場合は環境設定を使用
+	KeyArns     map[string]string        // データ分類をキーにしたARN（未指定の分類はKeyStackのExportから参照）
+}
+
+// DataKeys データ分類別のカスタマー管理キーをまとめたL3コンストラクト
+type DataKeys struct {
+	constructs.Construct
+
+	Config *config.EncryptionConfig
+
+	keys map[string]awskms.IKey
+}
+
+// dataKeyServices データ分類ごとにキーの使用を許可するサービス（kms:ViaService）
+var dataKeyServices = map[string][]string{
+	config.DataClassDatabase:  {"rds"},
+	config.DataClassCache:     {"elasticache"},
+	config.DataClassLogs:      {"logs"},
+	config.DataClassBackups:   {"s3", "backup"},
+	config.DataClassSecrets:   {"secretsmanager"},
+	config.DataClassArtifacts: {"ecr"},
+	config.DataClassFiles:     {"elasticfilesystem"},
+}
+
+// NewDataKeys 設定からデータ分類別のカスタマー管理キーを作成
+func NewDataKeys(scope constructs.Construct, id string, props *DataKeysProps) *DataKeys {
+	encryptionConfig := resolveEncryptionConfig(props.Environment, props.Config)
+
+	k := &DataKeys{
+		Construct: constructs.NewConstruct(scope, jsii.String(id)),
+		Config:    encryptionConfig,
+		keys:      make(map[string]awskms.IKey),
+	}
+
+	for _, dataClass := range encryptionConfig.DataClasses {
+		k.keys[dataClass] = awskms.NewKey(k.Construct, jsii.String(dataKeyId(dataClass)), &awskms.KeyProps{
+			Alias:             jsii.String("alias/" + props.Name + "-" + dataClass),
+			Description:       jsii.String("Customer managed key for " + dataClass + " data of " + props.Name),
+			EnableKeyRotation: jsii.Bool(true),
+			RotationPeriod:    awscdk.Duration_Days(jsii.Number(encryptionConfig.RotationPeriod())),
+			PendingWindow:     awscdk.Duration_Days(jsii.Number(encryptionConfig.PendingWindow())),
+			Policy:            dataKeyPolicy(dataClass),
+			RemovalPolicy:     awscdk.RemovalPolicy_RETAIN,
+		})
+	}
+
+	return k
+}
+
+// ImportDataKeys 他のStackで作成されたデータ分類別のキーをARNで参照
+func ImportDataKeys(scope constructs.Construct, id string, props *DataKeysImportProps) *DataKeys {
+	encryptionConfig := resolveEncryptionConfig(props.Environment, props.Config)
+
+	k := &DataKeys{
+		Construct: constructs.NewConstruct(scope, jsii.String(id)),
+		Config:    encryptionConfig,
+		keys:      make(map[string]awskms.IKey),
+	}
+
+	for _, dataClass := range encryptionConfig.DataClasses {
+		keyArn, ok := props.KeyArns[dataClass]
+		var keyArnToken *string
+		if ok {
+			keyArnToken = jsii.String(keyArn)
+		} else {
+			// KeyStackからキーARNをインポート
+			keyArnToken = awscdk.Fn_ImportValue(jsii.String(DataKeyExportName(props.Environment, dataClass)))
+		}
+		k.keys[dataClass] = awskms.Key_FromKeyArn(k.Construct, jsii.String(dataKeyId(dataClass)), keyArnToken)
+	}
+
+	return k
+}
+
+// resolveEncryptionConfig 暗号化設定を取得して検証
+func resolveEncryptionConfig(environment string, encryptionConfig *config.EncryptionConfig) *config.EncryptionConfig {
+	if encryptionConfig == nil {
+		c := config.GetEncryptionConfig(environment)
+		encryptionConfig = &c
+	}
+	if err := config.ValidateEncryptionConfig(*encryptionConfig); err != nil {
+		panic("Invalid encryption configuration: " + err.Error())
+	}
+	return encryptionConfig
+}
+
+// DataKeyExportName データ分類のキーARNのExport名
+func DataKeyExportName(environment string, dataClass string) string {
+	return "Service-" + environment + "-" + dataClass + "-Key-Arn"
+}
+
+// dataKeyId データ分類のキーのコンストラクトID（DatabaseKey など）
+func dataKeyId(dataClass string) string {
+	return strings.ToUpper(dataClass[:1]) + dataClass[1:] + "Key"
+}
+
+// dataKeyPolicy データ分類のキーポリシー
+// アカウントには管理操作のみを許可し、暗号化・復号はこのアカウントからデータ分類に対応するサービス経由に限定
+func dataKeyPolicy(dataClass string) awsiam.PolicyDocument {
+	account := awsiam.NewAccountRootPrincipal()
+
+	viaServices := []*string{}
+	for _, service := range dataKeyServices[dataClass] {
+		viaServices = append(viaServices, awscdk.Fn_Sub(jsii.String(service+".${AWS::Region}.amazonaws.com"), nil))
+	}
+
+	statements := []awsiam.PolicyStatement{
+		awsiam.NewPolicyStatement(&awsiam.PolicyStatementProps{
+			Sid:        jsii.String("KeyAdministration"),
+			Principals: &[]awsiam.IPrincipal{account},
+			Actions: jsii.Strings(
+				"kms:Create*", "kms:Describe*", "kms:Enable*", "kms:List*", "kms:Put*", "kms:Update*",
+				"kms:Revoke*", "kms:Disable*", "kms:Get*", "kms:Delete*", "kms:TagResource", "kms:UntagResource",
+				"kms:ScheduleKeyDeletion", "kms:CancelKeyDeletion", "kms:RotateKeyOnDemand",
+			),
+			Resources: jsii.Strings("*"),
+		}),
+		// AWS管理キーと同様に、アカウント内のプリンシパルはIAMポリシーなしでサービス経由の使用を許可
+		// （インポートしたキーではシークレットの読み取り権限などにキーの権限が付与されないため）
+		awsiam.NewPolicyStatement(&awsiam.PolicyStatementProps{
+			Sid:        jsii.String("AllowUseViaService"),
+			Principals: &[]awsiam.IPrincipal{awsiam.NewAnyPrincipal()},
+			Actions: jsii.Strings(
+				"kms:Encrypt", "kms:Decrypt", "kms:ReEncrypt*", "kms:GenerateDataKey*", "kms:CreateGrant", "kms:DescribeKey",
+			),
+			Resources: jsii.Strings("*"),
+			Conditions: &map[string]interface{}{
+				"StringEquals": map[string]interface{}{
+					"kms:ViaService":    viaServices,
+					"kms:CallerAccount": awscdk.Aws_ACCOUNT_ID(),
+				},
+			},
+		}),
+	}
+
+	// CloudWatch Logsはサービスプリンシパルがキーを使用（このアカウント・リージョンのロググループに限定）
+	if dataClass == config.DataClassLogs {
+		statements = append(statements, awsiam.NewPolicyStatement(&awsiam.PolicyStatementProps{
+			Sid:        jsii.String("AllowCloudWatchLogs"),
+			Principals: &[]awsiam.IPrincipal{awsiam.NewServicePrincipal(awscdk.Fn_Sub(jsii.String("logs.${AWS::Region}.amazonaws.com"), nil), nil)},
+			Actions: jsii.Strings(
+				"kms:Encrypt*", "kms:Decrypt*", "kms:ReEncrypt*", "kms:GenerateDataKey*", "kms:Describe*",
+			),
+			Resources: jsii.Strings("*"),
+			Conditions: &map[string]interface{}{
+				"ArnLike": map[string]interface{}{
+					"kms:EncryptionContext:aws:logs:arn": awscdk.Fn_Sub(jsii.String("arn:${AWS::Partition}:logs:${AWS::Region}:${AWS::AccountId}:log-group:*"), nil),
+				},
+			},
+		}))
+	}
+
+	return awsiam.NewPolicyDocument(&awsiam.PolicyDocumentProps{
+		Statements: &statements,
+	})
+}
+
+// Key データ分類のキーを取得（CMKを使用しない分類の場合はnil）
+func (k *DataKeys) Key(dataClass string) awskms.IKey {
+	return k.keys[dataClass]
+}
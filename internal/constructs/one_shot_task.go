@@ -0,0 +1,153 @@
+package constructs
+
+import (
+	"github.com/aws/aws-cdk-go/awscdk/v2"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awsec2"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awsecs"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awsiam"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awslambda"
+	"github.com/aws/aws-cdk-go/awscdk/v2/customresources"
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+)
+
+// OneShotTaskProps OneShotTaskのプロパティ
+type OneShotTaskProps struct {
+	Cluster        awsecs.ICluster
+	TaskDefinition awsecs.TaskDefinition
+	VpcSubnets     *awsec2.SubnetSelection
+	SecurityGroups []awsec2.ISecurityGroup
+
+	// 値が変わった場合は更新時にも再実行（未指定の場合は作成時のみ実行）
+	Trigger *string
+
+	// タスクの終了を待機する上限（未指定の場合は30分）
+	Timeout awscdk.Duration
+}
+
+// OneShotTask デプロイ時にFargateタスクを一度だけ実行し、終了を待機するL3コンストラクト
+// RunTaskは起動のみで完了しないため、Providerフレームワークの完了判定でタスクの停止と終了コードを確認し、
+// 終了コードが0以外の場合はデプロイを失敗させる
+type OneShotTask struct {
+	constructs.Construct
+
+	Resource awscdk.CustomResource
+}
+
+// NewOneShotTask タスクを実行して終了を待機するカスタムリソースを作成
+func NewOneShotTask(scope constructs.Construct, id string, props *OneShotTaskProps) *OneShotTask {
+	t := &OneShotTask{
+		Construct: constructs.NewConstruct(scope, jsii.String(id)),
+	}
+
+	timeout := props.Timeout
+	if timeout == nil {
+		timeout = awscdk.Duration_Minutes(jsii.Number(30))
+	}
+
+	// 起動と完了判定は同じLambdaで処理（完了判定時のイベントにはonEventの結果のDataが含まれる）
+	handler := awslambda.NewFunction(t.Construct, jsii.String("Handler"), &awslambda.FunctionProps{
+		Description: jsii.String("Runs a one-shot ECS task and waits for it to stop"),
+		Runtime:     awslambda.Runtime_PYTHON_3_12(),
+		Handler:     jsii.String("index.handler"),
+		Code:        awslambda.Code_FromInline(jsii.String(oneShotTaskHandler)),
+		Timeout:     awscdk.Duration_Minutes(jsii.Number(1)),
+	})
+	handler.AddToRolePolicy(awsiam.NewPolicyStatement(&awsiam.PolicyStatementProps{
+		Actions:   jsii.Strings("ecs:RunTask"),
+		Resources: &[]*string{props.TaskDefinition.TaskDefinitionArn()},
+	}))
+	handler.AddToRolePolicy(awsiam.NewPolicyStatement(&awsiam.PolicyStatementProps{
+		Actions:   jsii.Strings("iam:PassRole"),
+		Resources: &[]*string{props.TaskDefinition.TaskRole().RoleArn(), props.TaskDefinition.ObtainExecutionRole().RoleArn()},
+	}))
+	handler.AddToRolePolicy(awsiam.NewPolicyStatement(&awsiam.PolicyStatementProps{
+		Actions:   jsii.Strings("ecs:DescribeTasks"),
+		Resources: jsii.Strings("*"),
+		Conditions: &map[string]interface{}{
+			"ArnEquals": map[string]interface{}{"ecs:cluster": props.Cluster.ClusterArn()},
+		},
+	}))
+
+	provider := customresources.NewProvider(t.Construct, jsii.String("Provider"), &customresources.ProviderProps{
+		OnEventHandler:    handler,
+		IsCompleteHandler: handler,
+		QueryInterval:     awscdk.Duration_Seconds(jsii.Number(30)),
+		TotalTimeout:      timeout,
+	})
+
+	securityGroupIds := []*string{}
+	for _, securityGroup := range props.SecurityGroups {
+		securityGroupIds = append(securityGroupIds, securityGroup.SecurityGroupId())
+	}
+
+	properties := map[string]interface{}{
+		"Cluster":        props.Cluster.ClusterArn(),
+		"TaskDefinition": props.TaskDefinition.TaskDefinitionArn(),
+		"Subnets":        props.Cluster.Vpc().SelectSubnets(props.VpcSubnets).SubnetIds,
+		"SecurityGroups": &securityGroupIds,
+	}
+	if props.Trigger != nil {
+		properties["Trigger"] = props.Trigger
+	}
+
+	t.Resource = awscdk.NewCustomResource(t.Construct, jsii.String("Resource"), &awscdk.CustomResourceProps{
+		ServiceToken: provider.ServiceToken(),
+		ResourceType: jsii.String("Custom::OneShotTask"),
+		Properties:   &properties,
+	})
+
+	return t
+}
+
+// oneShotTaskHandler タスクの起動（onEvent）と停止・終了コードの確認（isComplete）
+// 更新時はTriggerが変わった場合のみ再実行し、削除時は何もしない
+const oneShotTaskHandler = `import boto3
+
+ecs = boto3.client("ecs")
+
+
+def handler(event, context):
+    if "Data" in event:
+        return is_complete(event)
+    return on_event(event)
+
+
+def on_event(event):
+    props = event["ResourceProperties"]
+    result = {"PhysicalResourceId": event.get("PhysicalResourceId", event["LogicalResourceId"]), "Data": {"TaskArn": ""}}
+    if event["RequestType"] == "Delete":
+        return result
+    if event["RequestType"] == "Update" and props.get("Trigger") == event["OldResourceProperties"].get("Trigger"):
+        return result
+
+    response = ecs.run_task(
+        cluster=props["Cluster"],
+        taskDefinition=props["TaskDefinition"],
+        launchType="FARGATE",
+        networkConfiguration={"awsvpcConfiguration": {
+            "subnets": props["Subnets"], "securityGroups": props["SecurityGroups"], "assignPublicIp": "DISABLED",
+        }},
+    )
+    if response["failures"] or not response["tasks"]:
+        raise Exception("Failed to run task: %s" % response["failures"])
+    result["Data"]["TaskArn"] = response["tasks"][0]["taskArn"]
+    return result
+
+
+def is_complete(event):
+    task_arn = event["Data"]["TaskArn"]
+    if not task_arn:
+        return {"IsComplete": True}
+
+    tasks = ecs.describe_tasks(cluster=event["ResourceProperties"]["Cluster"], tasks=[task_arn])["tasks"]
+    if not tasks:
+        raise Exception("Task not found: %s" % task_arn)
+    if tasks[0]["lastStatus"] != "STOPPED":
+        return {"IsComplete": False}
+
+    failed = ["%s exited with %s %s" % (c["name"], c.get("exitCode"), c.get("reason", "")) for c in tasks[0]["containers"] if c.get("exitCode") != 0]
+    if failed:
+        raise Exception("Task %s failed: %s %s" % (task_arn, "; ".join(failed), tasks[0].get("stoppedReason", "")))
+    return {"IsComplete": True}
+`
@@ -1,142 +1,286 @@
 package constructs
 
 import (
+	"aws-ecs-fargate-go-cdk/internal/config"
+	"fmt"
+	"strings"
+
 	"github.com/aws/aws-cdk-go/awscdk/v2"
 	"github.com/aws/aws-cdk-go/awscdk/v2/awsec2"
 	"github.com/aws/constructs-go/constructs/v10"
 	"github.com/aws/jsii-runtime-go"
 )
 
-// SecurityGroupsProps セキュリティグループ作成のプロパティ
-type SecurityGroupsProps struct {
+// ServiceSecurityGroupsProps ServiceSecurityGroupsのプロパティ
+type ServiceSecurityGroupsProps struct {
 	Vpc         awsec2.IVpc
 	Environment string
+	Matrix      *config.SecurityMatrixConfig // 未指定の場合は環境設定を使用
 }
 
-// SecurityGroupsResult セキュリティグループの作成結果
-type SecurityGroupsResult struct {
-	ALBSecurityGroup awsec2.SecurityGroup
-	ECSSecurityGroup awsec2.SecurityGroup
-	RDSSecurityGroup awsec2.SecurityGroup
+// ServiceSecurityGroupsImportProps 既存のセキュリティグループを参照する場合のプロパティ
+type ServiceSecurityGroupsImportProps struct {
+	Environment      string
+	Tiers            []string          // 参照するティア名
+	SecurityGroupIds map[string]string // ティア名をキーにしたID（未指定のティアはNetworkStackのExportから参照）
+	AllowAllOutbound bool              // falseの場合、許可追加時に送信元のEgressルールも作成
+	ReadOnly         bool              // trueの場合、参照先にルールを追加しない（ルールはセキュリティグループ定義で管理）
 }
 
-// CreateSecurityGroups セキュリティグループ群を作成（関数ベース）
-func CreateSecurityGroups(scope constructs.Construct, props *SecurityGroupsProps) *SecurityGroupsResult {
-	result := &SecurityGroupsResult{}
+// ServiceSecurityGroups ティア別セキュリティグループをまとめたL3コンストラクト
+type ServiceSecurityGroups struct {
+	constructs.Construct
+
+	// 作成時に使用したセキュリティグループ定義（参照時はnil）
+	Matrix *config.SecurityMatrixConfig
+
+	securityGroups map[string]awsec2.ISecurityGroup
+}
 
-	// ALB用セキュリティグループ
-	result.ALBSecurityGroup = createALBSecurityGroup(scope, props)
+// NewServiceSecurityGroups セキュリティグループ定義からティア別セキュリティグループを作成
+func NewServiceSecurityGroups(scope constructs.Construct, id string, props *ServiceSecurityGroupsProps) *ServiceSecurityGroups {
+	matrix := props.Matrix
+	if matrix == nil {
+		matrix = config.GetSecurityMatrixConfig(props.Environment)
+	}
+	if err := config.ValidateSecurityMatrixConfig(matrix); err != nil {
+		panic("Invalid security matrix: " + err.Error())
+	}
 
-	// ECS用セキュリティグループ
-	result.ECSSecurityGroup = createECSSecurityGroup(scope, props, result.ALBSecurityGroup)
+	s := &ServiceSecurityGroups{
+		Construct:      constructs.NewConstruct(scope, jsii.String(id)),
+		Matrix:         matrix,
+		securityGroups: make(map[string]awsec2.ISecurityGroup),
+	}
 
-	// RDS用セキュリティグループ
-	result.RDSSecurityGroup = createRDSSecurityGroup(scope, props, result.ECSSecurityGroup)
+	// ティアごとにセキュリティグループを作成
+	created := make(map[string]awsec2.SecurityGroup)
+	for _, tier := range matrix.Tiers {
+		created[tier.Name] = createTierSecurityGroup(s.Construct, props, tier, matrix.RestrictEgress)
+		s.securityGroups[tier.Name] = created[tier.Name]
+	}
 
-	return result
+	// ルールを各ティアに適用
+	for _, rule := range matrix.Rules {
+		if rule.IsEgress() {
+			addEgressRule(s.Construct, created[rule.Tier], created, rule)
+			continue
+		}
+		addIngressRule(s.Construct, created[rule.Tier], created, rule)
+
+		// Egress制限時はティア間Ingressと対になるEgressを送信元ティアに追加
+		if matrix.RestrictEgress && rule.PeerType == config.PeerTypeTier {
+			addMirroredEgressRule(created, rule)
+		}
+	}
+
+	return s
 }
 
-// createALBSecurityGroup ALB用セキュリティグループを作成
-func createALBSecurityGroup(scope constructs.Construct, props *SecurityGroupsProps) awsec2.SecurityGroup {
-	albSG := awsec2.NewSecurityGroup(scope, jsii.String("ALBSecurityGroup"), &awsec2.SecurityGroupProps{
-		Vpc:               props.Vpc,
-		Description:       jsii.String("Security group for ALB"),
-		SecurityGroupName: jsii.String("Service-" + props.Environment + "-ALB-SG"),
-		AllowAllOutbound:  jsii.Bool(true),
-	})
+// ImportServiceSecurityGroups 他のStackで作成されたティア別セキュリティグループをIDで参照
+func ImportServiceSecurityGroups(scope constructs.Construct, id string, props *ServiceSecurityGroupsImportProps) *ServiceSecurityGroups {
+	s := &ServiceSecurityGroups{
+		Construct:      constructs.NewConstruct(scope, jsii.String(id)),
+		securityGroups: make(map[string]awsec2.ISecurityGroup),
+	}
 
-	// HTTP/HTTPS アクセス許可
-	addHTTPIngressRules(albSG)
+	for _, tier := range props.Tiers {
+		sgId, ok := props.SecurityGroupIds[tier]
+		var sgIdToken *string
+		if ok {
+			sgIdToken = jsii.String(sgId)
+		} else {
+			// NetworkStackからセキュリティグループIDをインポート
+			sgIdToken = awscdk.Fn_ImportValue(jsii.String(SecurityGroupExportName(props.Environment, tier)))
+		}
 
-	// タグ追加
-	awscdk.Tags_Of(albSG).Add(jsii.String("Name"), jsii.String("Service-"+props.Environment+"-ALB-SG"), nil)
-	awscdk.Tags_Of(albSG).Add(jsii.String("Environment"), jsii.String(props.Environment), nil)
-	awscdk.Tags_Of(albSG).Add(jsii.String("Component"), jsii.String("LoadBalancer"), nil)
+		s.securityGroups[tier] = awsec2.SecurityGroup_FromSecurityGroupId(s.Construct, jsii.String(tier+"SecurityGroup"), sgIdToken, &awsec2.SecurityGroupImportOptions{
+			AllowAllOutbound: jsii.Bool(props.AllowAllOutbound),
+			Mutable:          jsii.Bool(!props.ReadOnly),
+		})
+	}
 
-	return albSG
+	return s
 }
 
-// createECSSecurityGroup ECS用セキュリティグループを作成
-func createECSSecurityGroup(scope constructs.Construct, props *SecurityGroupsProps, albSG awsec2.SecurityGroup) awsec2.SecurityGroup {
-	ecsSG := awsec2.NewSecurityGroup(scope, jsii.String("ECSSecurityGroup"), &awsec2.SecurityGroupProps{
-		Vpc:               props.Vpc,
-		Description:       jsii.String("Security group for ECS tasks"),
-		SecurityGroupName: jsii.String("Service-" + props.Environment + "-ECS-SG"),
-		AllowAllOutbound:  jsii.Bool(true),
-	})
+// SecurityGroupExportName ティアのセキュリティグループIDのExport名
+func SecurityGroupExportName(environment string, tier string) string {
+	return "Service-" + environment + "-" + tier + "-SG-Id"
+}
 
-	// ALBからのアクセス許可
-	ecsSG.AddIngressRule(
-		awsec2.Peer_SecurityGroupId(albSG.SecurityGroupId(), nil),
-		awsec2.Port_Tcp(jsii.Number(80)),
-		jsii.String("Allow HTTP traffic from ALB"),
-		jsii.Bool(false),
-	)
+// SecurityGroup ティアのセキュリティグループを取得
+func (s *ServiceSecurityGroups) SecurityGroup(tier string) awsec2.ISecurityGroup {
+	sg, ok := s.securityGroups[tier]
+	if !ok {
+		panic("Unknown security tier: " + tier)
+	}
+	return sg
+}
 
-	// 動的ポート範囲のアクセス許可（ECS Dynamic Port Mapping用）
-	ecsSG.AddIngressRule(
-		awsec2.Peer_SecurityGroupId(albSG.SecurityGroupId(), nil),
-		awsec2.Port_TcpRange(jsii.Number(32768), jsii.Number(65535)),
-		jsii.String("Allow dynamic port range from ALB"),
-		jsii.Bool(false),
-	)
+// Tier ティアを接続可能なリソースとして取得
+func (s *ServiceSecurityGroups) Tier(tier string) awsec2.IConnectable {
+	return s.SecurityGroup(tier)
+}
 
-	// タグ追加
-	awscdk.Tags_Of(ecsSG).Add(jsii.String("Name"), jsii.String("Service-"+props.Environment+"-ECS-SG"), nil)
-	awscdk.Tags_Of(ecsSG).Add(jsii.String("Environment"), jsii.String(props.Environment), nil)
-	awscdk.Tags_Of(ecsSG).Add(jsii.String("Component"), jsii.String("Application"), nil)
+// Connections ティアのConnectionsを取得
+func (s *ServiceSecurityGroups) Connections(tier string) awsec2.Connections {
+	return s.SecurityGroup(tier).Connections()
+}
 
-	return ecsSG
+// AllowFrom 送信元からティアへの通信を許可
+func (s *ServiceSecurityGroups) AllowFrom(peer awsec2.IConnectable, tier string, port awsec2.Port, description string) {
+	s.Connections(tier).AllowFrom(peer, port, jsii.String(description))
 }
 
-// createRDSSecurityGroup RDS用セキュリティグループを作成
-func createRDSSecurityGroup(scope constructs.Construct, props *SecurityGroupsProps, ecsSG awsec2.SecurityGroup) awsec2.SecurityGroup {
-	rdsSG := awsec2.NewSecurityGroup(scope, jsii.String("RDSSecurityGroup"), &awsec2.SecurityGroupProps{
-		Vpc:               props.Vpc,
-		Description:       jsii.String("Security group for RDS database"),
-		SecurityGroupName: jsii.String("Service-" + props.Environment + "-RDS-SG"),
-		AllowAllOutbound:  jsii.Bool(false), // データベースは外部通信不要
-	})
+// AllowAppToDatabase ECSタスクからデータベースへの通信を許可（protocolはルールの説明に使用）
+func (s *ServiceSecurityGroups) AllowAppToDatabase(port int, protocol string) {
+	s.AllowFrom(s.Tier("ECS"), "RDS", awsec2.Port_Tcp(jsii.Number(port)), "Allow "+protocol+" traffic from ECS")
+}
 
-	// MySQL アクセス許可
-	rdsSG.AddIngressRule(
-		awsec2.Peer_SecurityGroupId(ecsSG.SecurityGroupId(), nil),
-		awsec2.Port_Tcp(jsii.Number(3306)),
-		jsii.String("Allow MySQL traffic from ECS"),
-		jsii.Bool(false),
-	)
+// AllowAppToCache ECSタスクからキャッシュへの通信を許可（engineはルールの説明に使用）
+func (s *ServiceSecurityGroups) AllowAppToCache(port int, engine string) {
+	s.AllowFrom(s.Tier("ECS"), "Cache", awsec2.Port_Tcp(jsii.Number(port)), "Allow "+engine+" traffic from ECS")
+}
 
-	// Redis アクセス許可
-	rdsSG.AddIngressRule(
-		awsec2.Peer_SecurityGroupId(ecsSG.SecurityGroupId(), nil),
-		awsec2.Port_Tcp(jsii.Number(6379)),
-		jsii.String("Allow Redis traffic from ECS"),
-		jsii.Bool(false),
-	)
+// AllowAppToDatabaseProxy ECSタスクからRDS Proxy経由でデータベースへの通信を許可
+func (s *ServiceSecurityGroups) AllowAppToDatabaseProxy(proxy awsec2.IConnectable, port int) {
+	dbPort := awsec2.Port_Tcp(jsii.Number(port))
+	proxy.Connections().AllowFrom(s.Tier("ECS"), dbPort, jsii.String("Allow database traffic from ECS"))
+	s.AllowFrom(proxy, "RDS", dbPort, "Allow database traffic from RDS Proxy")
+}
+
+// AllowAppToFileSystem ECSタスクからEFSのマウントターゲットへの通信を許可
+func (s *ServiceSecurityGroups) AllowAppToFileSystem(fileSystem awsec2.IConnectable, port int) {
+	fileSystem.Connections().AllowFrom(s.Tier("ECS"), awsec2.Port_Tcp(jsii.Number(port)), jsii.String("Allow NFS traffic from ECS"))
+}
+
+// createTierSecurityGroup ティア定義からセキュリティグループを作成
+func createTierSecurityGroup(scope constructs.Construct, props *ServiceSecurityGroupsProps, tier config.SecurityTierConfig, restrictEgress bool) awsec2.SecurityGroup {
+	sgName := "Service-" + props.Environment + "-" + tier.Name + "-SG"
+
+	sg := awsec2.NewSecurityGroup(scope, jsii.String(tier.Name+"SecurityGroup"), &awsec2.SecurityGroupProps{
+		Vpc:               props.Vpc,
+		Description:       jsii.String(tier.Description),
+		SecurityGroupName: jsii.String(sgName),
+		AllowAllOutbound:  jsii.Bool(tier.AllowAllOutbound && !restrictEgress),
+	})
 
 	// タグ追加
-	awscdk.Tags_Of(rdsSG).Add(jsii.String("Name"), jsii.String("Service-"+props.Environment+"-RDS-SG"), nil)
-	awscdk.Tags_Of(rdsSG).Add(jsii.String("Environment"), jsii.String(props.Environment), nil)
-	awscdk.Tags_Of(rdsSG).Add(jsii.String("Component"), jsii.String("Database"), nil)
+	awscdk.Tags_Of(sg).Add(jsii.String("Name"), jsii.String(sgName), nil)
+	awscdk.Tags_Of(sg).Add(jsii.String("Environment"), jsii.String(props.Environment), nil)
+	if tier.Component != "" {
+		awscdk.Tags_Of(sg).Add(jsii.String("Component"), jsii.String(tier.Component), nil)
+	}
 
-	return rdsSG
+	return sg
 }
 
-// addHTTPIngressRules HTTP/HTTPSのIngressルールを追加
-func addHTTPIngressRules(securityGroup awsec2.SecurityGroup) {
-	// HTTP (80)
+// addIngressRule ルール定義からIngressルールを追加
+func addIngressRule(scope constructs.Construct, securityGroup awsec2.SecurityGroup, tiers map[string]awsec2.SecurityGroup, rule config.SecurityRuleConfig) {
 	securityGroup.AddIngressRule(
-		awsec2.Peer_AnyIpv4(),
-		awsec2.Port_Tcp(jsii.Number(80)),
-		jsii.String("Allow HTTP traffic from internet"),
+		toPeer(scope, tiers, rule),
+		toPort(rule),
+		jsii.String(rule.Description),
 		jsii.Bool(false),
 	)
+}
 
-	// HTTPS (443)
-	securityGroup.AddIngressRule(
-		awsec2.Peer_AnyIpv4(),
-		awsec2.Port_Tcp(jsii.Number(443)),
-		jsii.String("Allow HTTPS traffic from internet"),
+// addEgressRule ルール定義からEgressルールを追加
+func addEgressRule(scope constructs.Construct, securityGroup awsec2.SecurityGroup, tiers map[string]awsec2.SecurityGroup, rule config.SecurityRuleConfig) {
+	securityGroup.AddEgressRule(
+		toPeer(scope, tiers, rule),
+		toPort(rule),
+		jsii.String(rule.Description),
 		jsii.Bool(false),
 	)
 }
+
+// addMirroredEgressRule ティア間Ingressルールに対応するEgressルールを送信元ティアに追加
+// （インラインルールにすると双方向の参照で循環依存になるため個別リソースとして作成）
+func addMirroredEgressRule(tiers map[string]awsec2.SecurityGroup, rule config.SecurityRuleConfig) {
+	source := tiers[rule.Peer]
+	protocol, fromPort, toPort := toCfnProtocol(rule)
+
+	awsec2.NewCfnSecurityGroupEgress(source, jsii.String(fmt.Sprintf("EgressTo%s%s%d", rule.Tier, strings.ToUpper(rule.Protocol), rule.FromPort)), &awsec2.CfnSecurityGroupEgressProps{
+		GroupId:                    source.SecurityGroupId(),
+		DestinationSecurityGroupId: tiers[rule.Tier].SecurityGroupId(),
+		IpProtocol:                 jsii.String(protocol),
+		FromPort:                   jsii.Number(fromPort),
+		ToPort:                     jsii.Number(toPort),
+		Description:                jsii.String(rule.Description),
+	})
+}
+
+// toCfnProtocol ルールのプロトコル・ポートをCloudFormationの表現に変換
+func toCfnProtocol(rule config.SecurityRuleConfig) (string, int, int) {
+	switch rule.Protocol {
+	case "icmp":
+		return "icmp", -1, -1
+	case "all":
+		return "-1", -1, -1
+	default:
+		return rule.Protocol, rule.FromPort, rule.ToPort
+	}
+}
+
+// toPeer ルールの送信元（Egressの場合は宛先）をCDKのピアに変換
+func toPeer(scope constructs.Construct, tiers map[string]awsec2.SecurityGroup, rule config.SecurityRuleConfig) awsec2.IPeer {
+	switch rule.PeerType {
+	case config.PeerTypeTier:
+		return awsec2.Peer_SecurityGroupId(tiers[rule.Peer].SecurityGroupId(), nil)
+	case config.PeerTypeCIDR:
+		return awsec2.Peer_Ipv4(jsii.String(rule.Peer))
+	case config.PeerTypePrefixList:
+		return awsec2.Peer_PrefixList(jsii.String(rule.Peer))
+	case config.PeerTypeCloudFront:
+		return awsec2.Peer_PrefixList(getCloudFrontPrefixList(scope).PrefixListId())
+	case config.PeerTypeAWSService:
+		return awsec2.Peer_PrefixList(getAWSServicePrefixList(scope, rule.Peer).PrefixListId())
+	default:
+		return awsec2.Peer_AnyIpv4()
+	}
+}
+
+// toPort ルールのプロトコル・ポートをCDKのポートに変換
+func toPort(rule config.SecurityRuleConfig) awsec2.Port {
+	switch rule.Protocol {
+	case "udp":
+		if rule.FromPort == rule.ToPort {
+			return awsec2.Port_Udp(jsii.Number(rule.FromPort))
+		}
+		return awsec2.Port_UdpRange(jsii.Number(rule.FromPort), jsii.Number(rule.ToPort))
+	case "icmp":
+		return awsec2.Port_AllIcmp()
+	case "all":
+		return awsec2.Port_AllTraffic()
+	default:
+		if rule.FromPort == rule.ToPort {
+			return awsec2.Port_Tcp(jsii.Number(rule.FromPort))
+		}
+		return awsec2.Port_TcpRange(jsii.Number(rule.FromPort), jsii.Number(rule.ToPort))
+	}
+}
+
+// getCloudFrontPrefixList CloudFrontのorigin-facingプレフィックスリストを参照（リージョンごとにIDが異なるためLookup）
+func getCloudFrontPrefixList(scope constructs.Construct) awsec2.IPrefixList {
+	return lookupPrefixList(scope, "CloudFrontOriginFacingPrefixList", config.CloudFrontOriginFacingPrefixList)
+}
+
+// getAWSServicePrefixList AWSサービス（s3 など）のマネージドプレフィックスリストを参照
+func getAWSServicePrefixList(scope constructs.Construct, service string) awsec2.IPrefixList {
+	region := awscdk.Stack_Of(scope).Region()
+	if *awscdk.Token_IsUnresolved(region) {
+		panic("aws-service peer requires a stack with an explicit region: " + service)
+	}
+	return lookupPrefixList(scope, "AWSService-"+service+"-PrefixList", "com.amazonaws."+*region+"."+service)
+}
+
+// lookupPrefixList プレフィックスリストを名前でLookup（同一スコープ内では1つを共有）
+func lookupPrefixList(scope constructs.Construct, id string, name string) awsec2.IPrefixList {
+	if existing := scope.Node().TryFindChild(jsii.String(id)); existing != nil {
+		return existing.(awsec2.IPrefixList)
+	}
+	return awsec2.PrefixList_FromLookup(scope, jsii.String(id), &awsec2.PrefixListLookupOptions{
+		PrefixListName: jsii.String(name),
+	})
+}
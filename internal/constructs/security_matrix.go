@@ -0,0 +1,92 @@
+package constructs
+
+import (
+	"aws-ecs-fargate-go-cdk/internal/config"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strings"
+)
+
+// ReachabilityEntry 到達性テーブルの1行
+type ReachabilityEntry struct {
+	Source      string
+	Destination string
+	Protocol    string
+	Ports       string
+	Description string
+}
+
+// BuildReachabilityTable セキュリティグループ定義から到達性テーブルを作成
+func BuildReachabilityTable(matrix *config.SecurityMatrixConfig) []ReachabilityEntry {
+	entries := make([]ReachabilityEntry, 0, len(matrix.Rules))
+	for _, rule := range matrix.Rules {
+		entry := ReachabilityEntry{
+			Source:      describePeer(rule),
+			Destination: rule.Tier,
+			Protocol:    rule.Protocol,
+			Ports:       describePorts(rule),
+			Description: rule.Description,
+		}
+		if rule.IsEgress() {
+			entry.Source, entry.Destination = rule.Tier, describePeer(rule)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// RenderReachabilityMarkdown 到達性テーブルをMarkdown形式で出力（セキュリティレビュー用）
+func RenderReachabilityMarkdown(matrix *config.SecurityMatrixConfig) string {
+	var b strings.Builder
+	b.WriteString("| Source | Destination | Protocol | Ports | Description |\n")
+	b.WriteString("|---|---|---|---|---|\n")
+	for _, e := range BuildReachabilityTable(matrix) {
+		fmt.Fprintf(&b, "| %s | %s | %s | %s | %s |\n", e.Source, e.Destination, e.Protocol, e.Ports, e.Description)
+	}
+	return b.String()
+}
+
+// RenderReachabilityCSV 到達性テーブルをCSV形式で出力
+func RenderReachabilityCSV(matrix *config.SecurityMatrixConfig) (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if err := w.Write([]string{"source", "destination", "protocol", "ports", "description"}); err != nil {
+		return "", err
+	}
+	for _, e := range BuildReachabilityTable(matrix) {
+		if err := w.Write([]string{e.Source, e.Destination, e.Protocol, e.Ports, e.Description}); err != nil {
+			return "", err
+		}
+	}
+
+	w.Flush()
+	return buf.String(), w.Error()
+}
+
+// describePeer ルールのピアを表示用文字列に変換
+func describePeer(rule config.SecurityRuleConfig) string {
+	switch rule.PeerType {
+	case config.PeerTypeTier, config.PeerTypeCIDR, config.PeerTypePrefixList:
+		return rule.Peer
+	case config.PeerTypeCloudFront:
+		return "CloudFront"
+	case config.PeerTypeAWSService:
+		return "AWS:" + rule.Peer
+	default:
+		return "0.0.0.0/0"
+	}
+}
+
+// describePorts ルールのポート範囲を表示用文字列に変換
+func describePorts(rule config.SecurityRuleConfig) string {
+	switch rule.Protocol {
+	case "icmp", "all":
+		return "all"
+	}
+	if rule.FromPort == rule.ToPort {
+		return fmt.Sprintf("%d", rule.FromPort)
+	}
+	return fmt.Sprintf("%d-%d", rule.FromPort, rule.ToPort)
+}
@@ -0,0 +1,139 @@
+package constructs
+
+import (
+	"fmt"
+
+	"aws-ecs-fargate-go-cdk/internal/config"
+
+	"github.com/aws/aws-cdk-go/awscdk/v2"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awscertificatemanager"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awscloudfront"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awscloudfrontorigins"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awss3"
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+)
+
+// StaticAssetsCDNProps StaticAssetsCDNのプロパティ
+type StaticAssetsCDNProps struct {
+	Bucket awss3.IBucket
+	Config *config.CDNConfig
+
+	// キャッシュポリシー名のプレフィックス（アカウント内で一意）
+	Name string
+}
+
+// StaticAssetsCDN 静的アセット用S3バケットをオリジンとするCloudFrontのL3コンストラクト
+// バケットへのアクセスはOrigin Access Control経由でこのディストリビューションからのみ許可
+type StaticAssetsCDN struct {
+	constructs.Construct
+
+	Config       *config.CDNConfig
+	Distribution awscloudfront.Distribution
+}
+
+// NewStaticAssetsCDN 設定から静的アセット配信用のCloudFrontディストリビューションを作成
+func NewStaticAssetsCDN(scope constructs.Construct, id string, props *StaticAssetsCDNProps) *StaticAssetsCDN {
+	if err := config.ValidateCDNConfig(*props.Config); err != nil {
+		panic("Invalid CDN configuration: " + err.Error())
+	}
+
+	c := &StaticAssetsCDN{
+		Construct: constructs.NewConstruct(scope, jsii.String(id)),
+		Config:    props.Config,
+	}
+	cdnConfig := props.Config
+
+	// OACでバケットポリシーにディストリビューションのARNを条件とした読み取り権限を追加
+	origin := awscloudfrontorigins.S3BucketOrigin_WithOriginAccessControl(props.Bucket, nil)
+
+	defaultPolicy := createCDNCachePolicy(c.Construct, "DefaultCachePolicy", props.Name+"-default", cdnConfig.DefaultCache)
+	distributionProps := &awscloudfront.DistributionProps{
+		Comment:         jsii.String("Static assets for " + props.Name),
+		DefaultBehavior: cdnBehavior(origin, defaultPolicy),
+		PriceClass:      toPriceClass(cdnConfig.PriceClass),
+		HttpVersion:     awscloudfront.HttpVersion_HTTP2_AND_3,
+		EnableIpv6:      jsii.Bool(true),
+	}
+
+	// カスタムドメイン（us-east-1のACM証明書）
+	if cdnConfig.DomainName != "" {
+		distributionProps.DomainNames = jsii.Strings(cdnConfig.DomainName)
+		distributionProps.Certificate = awscertificatemanager.Certificate_FromCertificateArn(c.Construct, jsii.String("Certificate"), jsii.String(cdnConfig.CertificateArn))
+		distributionProps.MinimumProtocolVersion = awscloudfront.SecurityPolicyProtocol_TLS_V1_2_2021
+	}
+
+	c.Distribution = awscloudfront.NewDistribution(c.Construct, jsii.String("Distribution"), distributionProps)
+
+	// パス別のキャッシュポリシー（設定の順序がビヘイビアの優先順位）
+	for i, b := range cdnConfig.Behaviors {
+		policy := createCDNCachePolicy(c.Construct, fmt.Sprintf("CachePolicy%d", i+1), fmt.Sprintf("%s-%d", props.Name, i+1), b.Cache)
+		options := cdnBehavior(origin, policy)
+		c.Distribution.AddBehavior(jsii.String(b.PathPattern), origin, &awscloudfront.AddBehaviorOptions{
+			CachePolicy:          options.CachePolicy,
+			ViewerProtocolPolicy: options.ViewerProtocolPolicy,
+			AllowedMethods:       options.AllowedMethods,
+			CachedMethods:        options.CachedMethods,
+			Compress:             options.Compress,
+		})
+	}
+
+	return c
+}
+
+// createCDNCachePolicy TTL設定からキャッシュポリシーを作成
+// CORSのプリフライト・レスポンスがオリジン別にキャッシュされるようOrigin系ヘッダーをキャッシュキーに含める（オリジンにも転送される）
+func createCDNCachePolicy(scope constructs.Construct, id string, name string, cache config.CDNCacheConfig) awscloudfront.ICachePolicy {
+	return awscloudfront.NewCachePolicy(scope, jsii.String(id), &awscloudfront.CachePolicyProps{
+		CachePolicyName: jsii.String(name),
+		MinTtl:          awscdk.Duration_Seconds(jsii.Number(cache.MinTTLSeconds)),
+		DefaultTtl:      awscdk.Duration_Seconds(jsii.Number(cache.DefaultTTLSeconds)),
+		MaxTtl:          awscdk.Duration_Seconds(jsii.Number(cache.MaxTTLSeconds)),
+		HeaderBehavior: awscloudfront.CacheHeaderBehavior_AllowList(
+			jsii.String("Origin"),
+			jsii.String("Access-Control-Request-Method"),
+			jsii.String("Access-Control-Request-Headers"),
+		),
+		QueryStringBehavior:        awscloudfront.CacheQueryStringBehavior_None(),
+		CookieBehavior:             awscloudfront.CacheCookieBehavior_None(),
+		EnableAcceptEncodingGzip:   jsii.Bool(true),
+		EnableAcceptEncodingBrotli: jsii.Bool(true),
+	})
+}
+
+// cdnBehavior 静的アセット用のビヘイビア（HTTPSにリダイレクト、読み取りのみ）
+func cdnBehavior(origin awscloudfront.IOrigin, cachePolicy awscloudfront.ICachePolicy) *awscloudfront.BehaviorOptions {
+	return &awscloudfront.BehaviorOptions{
+		Origin:               origin,
+		CachePolicy:          cachePolicy,
+		ViewerProtocolPolicy: awscloudfront.ViewerProtocolPolicy_REDIRECT_TO_HTTPS,
+		AllowedMethods:       awscloudfront.AllowedMethods_ALLOW_GET_HEAD_OPTIONS(),
+		CachedMethods:        awscloudfront.CachedMethods_CACHE_GET_HEAD_OPTIONS(),
+		Compress:             jsii.Bool(true),
+	}
+}
+
+// toPriceClass 設定の価格クラスをCloudFrontの価格クラスに変換
+func toPriceClass(priceClass string) awscloudfront.PriceClass {
+	switch priceClass {
+	case config.CDNPriceClass100:
+		return awscloudfront.PriceClass_PRICE_CLASS_100
+	case config.CDNPriceClass200:
+		return awscloudfront.PriceClass_PRICE_CLASS_200
+	default:
+		return awscloudfront.PriceClass_PRICE_CLASS_ALL
+	}
+}
+
+// DomainName アセットの配信ドメイン（カスタムドメイン未指定の場合はCloudFrontのドメイン）
+func (c *StaticAssetsCDN) DomainName() *string {
+	if c.Config.DomainName != "" {
+		return jsii.String(c.Config.DomainName)
+	}
+	return c.Distribution.DistributionDomainName()
+}
+
+// URL アプリケーションに設定するアセットのURL（ASSET_URL）
+func (c *StaticAssetsCDN) URL() *string {
+	return awscdk.Fn_Join(jsii.String(""), &[]*string{jsii.String("https://"), c.DomainName()})
+}
@@ -2,6 +2,8 @@ package stacks
 
 import (
 	"aws-ecs-fargate-go-cdk/internal/config"
+	networkConstruct "aws-ecs-fargate-go-cdk/internal/constructs"
+	"strconv"
 
 	"github.com/aws/aws-cdk-go/awscdk/v2"
 	"github.com/aws/aws-cdk-go/awscdk/v2/awsapplicationautoscaling"
@@ -11,7 +13,9 @@ import (
 	"github.com/aws/aws-cdk-go/awscdk/v2/awsecs"
 	"github.com/aws/aws-cdk-go/awscdk/v2/awselasticloadbalancingv2"
 	"github.com/aws/aws-cdk-go/awscdk/v2/awsiam"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awskms"
 	"github.com/aws/aws-cdk-go/awscdk/v2/awslogs"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awsrds"
 	"github.com/aws/aws-cdk-go/awscdk/v2/awssecretsmanager"
 	"github.com/aws/aws-cdk-go/awscdk/v2/awsservicediscovery"
 	"github.com/aws/constructs-go/constructs/v10"
@@ -26,6 +30,38 @@ type ApplicationStackProps struct {
 	DatabaseEndpoint string
 	RedisEndpoint    string
 	TestEnvFlag      bool
+	DatabaseEngine   string // aurora-mysql, aurora-postgresql（未指定の場合は環境設定を使用、StorageStackと同じ設定にすること）
+
+	// StorageStackが管理するDB認証情報のシークレットARN（未指定の場合はStorageStackのExportを参照）
+	DatabaseSecretArn string
+
+	// StorageStackが管理するRedis認証情報のシークレットARN（未指定の場合はStorageStackのExportを参照）
+	RedisSecretArn string
+
+	// ALBのログを出力するStorageStackのログ用バケット名（未指定の場合はStorageStackのExportを参照）
+	LogsBucketName string
+
+	// StorageStackのCloudFrontの静的アセットURL（未指定の場合はStorageStackのExportを参照）
+	StaticAssetsUrl string
+
+	// RDS Proxyの設定（未指定の場合は環境設定を使用、StorageStackと同じ設定にすること）
+	DatabaseProxy *config.DatabaseProxyConfig
+
+	// データ分類別のKMSキー設定（未指定の場合は環境設定を使用、KeyStackと同じ設定にすること）
+	Encryption *config.EncryptionConfig
+
+	// タスク間で共有するEFS設定（未指定の場合は環境設定を使用、StorageStackと同じ設定にすること）
+	FileSystem *config.FileSystemConfig
+
+	// HTTPSリスナーのACM証明書ARN（未指定の場合は環境設定を使用、NetworkStackと同じ設定にすること）
+	ALBCertificate string
+
+	// trueの場合、環境設定に関わらずEgressを制限（NetworkStackと同じ設定にすること）
+	RestrictEgress bool
+
+	// セキュリティグループ定義（未指定の場合は環境設定を使用、NetworkStackと同じ設定にすること）
+	// 参照するティアは定義から取得し、Egress制限は定義のrestrictEgressを使用
+	SecurityMatrix *config.SecurityMatrixConfig
 }
 
 // VPCReferenceProps インターフェースの実装
@@ -73,6 +109,12 @@ func NewApplicationStack(scope constructs.Construct, id string, props *Applicati
 	if err != nil {
 		panic("Invalid environment: " + props.Environment)
 	}
+	if props.RestrictEgress {
+		envConfig.RestrictEgress = true
+	}
+
+	// セキュリティグループ定義（定義を指定した場合はEgress制限も定義に従う）
+	securityMatrix := resolveSecurityMatrix(props.Environment, props.SecurityMatrix, envConfig)
 
 	// VPCの参照を取得（ジェネリクス関数使用）
 	vpc := GetVPCReference(stack, props)
@@ -91,11 +133,26 @@ func NewApplicationStack(scope constructs.Construct, id string, props *Applicati
 		}(),
 	})
 
+	// KeyStackのデータ分類別キー（CMKを使用しない分類はAWS管理キー）
+	dataKeys := importDataKeys(stack, props.Environment, props.Encryption, props.TestEnvFlag)
+
 	// ECR Repository作成
-	ecrRepository := createECRRepository(stack, props.Environment, envConfig)
+	ecrRepository := createECRRepository(stack, props.Environment, envConfig, dataKeys)
+
+	// ALBのHTTPSリスナー設定（https-onlyの場合は証明書が必須）
+	albCertificate := props.ALBCertificate
+	if albCertificate == "" {
+		albCertificate = envConfig.ALBCertificate
+	}
+	if err := config.ValidateALBExposure(envConfig.ALBExposure, envConfig.RestrictedCIDRs, albCertificate); err != nil {
+		panic("Invalid ALB exposure: " + err.Error())
+	}
+
+	// NetworkStackのセキュリティグループを参照（ALB → ECSの許可を含む）
+	securityGroups := getApplicationSecurityGroups(stack, props.Environment, envConfig, securityMatrix)
 
 	// Application Load Balancer作成
-	alb := createApplicationLoadBalancer(stack, vpc, props.Environment)
+	alb := createApplicationLoadBalancer(stack, vpc, props.Environment, securityGroups, getLogsBucketName(props))
 
 	// // Target Group作成
 	// targetGroup := createTargetGroup(stack, vpc, props.Environment)
@@ -107,10 +164,13 @@ func NewApplicationStack(scope constructs.Construct, id string, props *Applicati
 	taskDefinition := createTaskDefinition(stack, ecsConfig, props)
 
 	// 🆕 Container Definitions作成
-	createContainerDefinitions(stack, taskDefinition, ecsConfig, ecrRepository, props)
+	createContainerDefinitions(stack, taskDefinition, ecsConfig, ecrRepository, props, dataKeys, envConfig.RestrictEgress)
 
 	// 🆕 ECS Service作成
-	ecsService, targetGroup := createECSServiceWithALB(stack, cluster, taskDefinition, alb, ecsConfig, vpc, props.Environment)
+	ecsService, targetGroup := createECSServiceWithALB(stack, cluster, taskDefinition, alb, ecsConfig, vpc, props.Environment, securityGroups)
+
+	// ALBリスナー作成（証明書がある場合はHTTPSで転送）
+	addALBListeners(stack, alb, targetGroup, envConfig.ALBExposure, albCertificate)
 
 	// 🆕 Service Discovery作成（本番環境のみ）
 	// var serviceDiscovery awsservicediscovery.Service
@@ -149,9 +209,21 @@ func NewApplicationStack(scope constructs.Construct, id string, props *Applicati
 }
 
 // createECRRepository ECR Repositoryを作成
-func createECRRepository(stack awscdk.Stack, environment string, envConfig *config.EnvironmentConfig) awsecr.Repository {
+// CMKへの移行時は新しいリポジトリ名で作成（イメージはデプロイ前に新しいリポジトリにコピー）
+func createECRRepository(stack awscdk.Stack, environment string, envConfig *config.EnvironmentConfig, dataKeys *networkConstruct.DataKeys) awsecr.Repository {
+	encryptionKey := dataKeys.Key(config.DataClassArtifacts)
+
 	return awsecr.NewRepository(stack, jsii.String("ServiceECRRepository"), &awsecr.RepositoryProps{
-		RepositoryName: jsii.String("service-" + environment),
+		RepositoryName: jsii.String(dataKeys.Config.ResourceName(config.DataClassArtifacts, "service-"+environment)),
+
+		// 暗号化設定（CMK未使用の場合はAES256）
+		Encryption: func() awsecr.RepositoryEncryption {
+			if encryptionKey != nil {
+				return awsecr.RepositoryEncryption_KMS()
+			}
+			return nil
+		}(),
+		EncryptionKey: encryptionKey,
 
 		// イメージスキャンを有効化
 		ImageScanOnPush: jsii.Bool(true),
@@ -236,8 +308,8 @@ func getECRLifecycleRules(environment string) *[]*awsecr.LifecycleRule {
 }
 
 // createApplicationLoadBalancer Application Load Balancerを作成
-func createApplicationLoadBalancer(stack awscdk.Stack, vpc awsec2.IVpc, environment string) awselasticloadbalancingv2.ApplicationLoadBalancer {
-	return awselasticloadbalancingv2.NewApplicationLoadBalancer(stack, jsii.String("ServiceALB"), &awselasticloadbalancingv2.ApplicationLoadBalancerProps{
+func createApplicationLoadBalancer(stack awscdk.Stack, vpc awsec2.IVpc, environment string, securityGroups *networkConstruct.ServiceSecurityGroups, logsBucketName *string) awselasticloadbalancingv2.ApplicationLoadBalancer {
+	alb := awselasticloadbalancingv2.NewApplicationLoadBalancer(stack, jsii.String("ServiceALB"), &awselasticloadbalancingv2.ApplicationLoadBalancerProps{
 		Vpc:              vpc,
 		InternetFacing:   jsii.Bool(true), // インターネット向け
 		LoadBalancerName: jsii.String("service-" + environment + "-alb"),
@@ -248,8 +320,38 @@ func createApplicationLoadBalancer(stack awscdk.Stack, vpc awsec2.IVpc, environm
 		},
 
 		// セキュリティグループ（Cross-stack参照）
-		SecurityGroup: getALBSecurityGroup(stack, environment),
+		SecurityGroup: securityGroups.SecurityGroup("ALB"),
 	})
+
+	// アクセスログ・接続ログ（ログ配信のバケットポリシーはStorageStackで設定）
+	alb.SetAttribute(jsii.String("access_logs.s3.enabled"), jsii.String("true"))
+	alb.SetAttribute(jsii.String("access_logs.s3.bucket"), logsBucketName)
+	alb.SetAttribute(jsii.String("access_logs.s3.prefix"), jsii.String(config.LogsPrefixALBAccess))
+	alb.SetAttribute(jsii.String("connection_logs.s3.enabled"), jsii.String("true"))
+	alb.SetAttribute(jsii.String("connection_logs.s3.bucket"), logsBucketName)
+	alb.SetAttribute(jsii.String("connection_logs.s3.prefix"), jsii.String(config.LogsPrefixALBConnection))
+
+	return alb
+}
+
+// getLogsBucketName ログ用バケット名を取得（テスト環境対応）
+func getLogsBucketName(props *ApplicationStackProps) *string {
+	if props.LogsBucketName != "" {
+		return jsii.String(props.LogsBucketName)
+	}
+
+	envConfig, err := config.GetEnvironmentConfig(props.Environment)
+	if err != nil {
+		panic("Invalid environment: " + props.Environment)
+	}
+
+	if props.TestEnvFlag {
+		// テスト環境では固定のバケット名
+		return jsii.String("service-" + envConfig.Name + "-logs")
+	}
+
+	// 実環境ではStorageStackのExportを参照
+	return awscdk.Fn_ImportValue(jsii.String("service-" + envConfig.Name + "-Logs-Bucket"))
 }
 
 // createTargetGroup Target Groupを作成
@@ -281,22 +383,15 @@ func createApplicationLoadBalancer(stack awscdk.Stack, vpc awsec2.IVpc, environm
 // 	})
 // }
 
-// getALBSecurityGroup ALB用セキュリティグループを取得（Cross-stack参照）
-func getALBSecurityGroup(stack awscdk.Stack, environment string) awsec2.ISecurityGroup {
-	envName := environment
-	if envName == "prod" {
-		envName = "production"
-	}
-
-	// NetworkStackからセキュリティグループIDをインポート
-	sgId := awscdk.Fn_ImportValue(jsii.String("Service-" + envName + "-ALB-SG-Id"))
-
-	return awsec2.SecurityGroup_FromSecurityGroupId(
-		stack,
-		jsii.String("ImportedALBSecurityGroup"),
-		sgId,
-		nil,
-	)
+// getApplicationSecurityGroups NetworkStackのティア別セキュリティグループを参照
+// セキュリティグループ定義の全ティアを参照（定義ファイルで追加したティアを含む）
+func getApplicationSecurityGroups(stack awscdk.Stack, environment string, envConfig *config.EnvironmentConfig, securityMatrix *config.SecurityMatrixConfig) *networkConstruct.ServiceSecurityGroups {
+	return networkConstruct.ImportServiceSecurityGroups(stack, "ImportedSecurityGroups", &networkConstruct.ServiceSecurityGroupsImportProps{
+		Environment:      environment,
+		Tiers:            securityMatrix.TierNames(),
+		AllowAllOutbound: !envConfig.RestrictEgress,
+		ReadOnly:         true, // ALB → ECSの許可（Egress制限時のEgressを含む）はNetworkStackのセキュリティグループ定義で作成済み
+	})
 }
 
 // createTaskDefinition Task Definitionを作成
@@ -320,13 +415,18 @@ func createTaskDefinition(stack awscdk.Stack, ecsConfig *config.ECSConfig, props
 	taskRole.AddToPolicy(awsiam.NewPolicyStatement(&awsiam.PolicyStatementProps{
 		Effect: awsiam.Effect_ALLOW,
 		Actions: &[]*string{
-			jsii.String("secretsmanager:GetSecretValue"),
 			jsii.String("ssm:GetParameter"),
 			jsii.String("ssm:GetParameters"),
 		},
 		Resources: &[]*string{jsii.String("*")},
 	}))
 
+	// RDS ProxyのIAM認証（アプリケーションユーザーとしての接続のみ許可）
+	dbConfig := getApplicationDatabaseConfig(props)
+	if dbConfig.Proxy.Enabled && dbConfig.Proxy.IAMAuth {
+		grantDatabaseProxyConnect(stack, taskRole, dbConfig, props)
+	}
+
 	return awsecs.NewFargateTaskDefinition(stack, jsii.String("ServiceTaskDefinition"), &awsecs.FargateTaskDefinitionProps{
 		Family:         jsii.String("service-" + props.Environment + "-task"),
 		Cpu:            jsii.Number(ecsConfig.CPU),
@@ -336,29 +436,136 @@ func createTaskDefinition(stack awscdk.Stack, ecsConfig *config.ECSConfig, props
 	})
 }
 
-// createSecretsConfiguration シークレット設定を作成（修正版）
-func createSecretsConfiguration(stack awscdk.Stack, props *ApplicationStackProps) map[string]awsecs.Secret {
-	secrets := make(map[string]awsecs.Secret)
+// getApplicationDatabaseConfig アプリケーションが接続するAurora設定を取得（プロパティの指定を反映）
+func getApplicationDatabaseConfig(props *ApplicationStackProps) *config.DatabaseConfig {
+	dbConfig := config.GetDatabaseConfig(props.Environment)
+	if props.DatabaseEngine != "" {
+		dbConfig.SetEngine(props.DatabaseEngine, dbConfig.EngineVersion)
+	}
+	if props.DatabaseProxy != nil {
+		dbConfig.Proxy = *props.DatabaseProxy
+	}
+	return dbConfig
+}
 
-	if !props.TestEnvFlag {
-		// 実環境用のSecrets Manager設定
-		dbSecret := awssecretsmanager.NewSecret(stack, jsii.String("DatabaseSecret"), &awssecretsmanager.SecretProps{
-			SecretName:  jsii.String("service-" + props.Environment + "-db-credentials"),
-			Description: jsii.String("Database credentials for " + props.Environment),
-			GenerateSecretString: &awssecretsmanager.SecretStringGenerator{
-				SecretStringTemplate: jsii.String(`{"username":"admin"}`),
-				GenerateStringKey:    jsii.String("password"),
-				ExcludeCharacters:    jsii.String(`"@/\`),
-			},
-		})
+// grantDatabaseProxyConnect Task RoleにRDS Proxyへのrds-db:connect権限を付与
+func grantDatabaseProxyConnect(stack awscdk.Stack, taskRole awsiam.IRole, dbConfig *config.DatabaseConfig, props *ApplicationStackProps) {
+	envConfig, err := config.GetEnvironmentConfig(props.Environment)
+	if err != nil {
+		panic("Invalid environment: " + props.Environment)
+	}
+
+	proxyArn := awscdk.Fn_ImportValue(jsii.String("service-" + envConfig.Name + "-DB-Proxy-Arn"))
+	if props.TestEnvFlag {
+		// テスト環境では固定のProxy ARN
+		proxyArn = jsii.String("arn:aws:rds:ap-northeast-1:123456789012:db-proxy:prx-0123456789abcdef0")
+	}
+
+	proxy := awsrds.DatabaseProxy_FromDatabaseProxyAttributes(stack, jsii.String("DatabaseProxy"), &awsrds.DatabaseProxyAttributes{
+		DbProxyArn:     proxyArn,
+		DbProxyName:    jsii.String("service-" + envConfig.Name + "-db-proxy"),
+		Endpoint:       getDatabaseHost(props, dbConfig),
+		SecurityGroups: &[]awsec2.ISecurityGroup{},
+	})
 
-		secrets["DB_PASSWORD"] = awsecs.Secret_FromSecretsManager(dbSecret, jsii.String("password"))
-		secrets["DB_USERNAME"] = awsecs.Secret_FromSecretsManager(dbSecret, jsii.String("username"))
+	// マルチユーザーローテーションではユーザー名が交互に切り替わるため両方に付与
+	users := []string{dbConfig.Credentials.AppUsername}
+	if dbConfig.Credentials.RotationDays > 0 {
+		users = append(users, dbConfig.Credentials.AppUsername+"_clone")
 	}
+	for _, user := range users {
+		proxy.GrantConnect(taskRole, jsii.String(user))
+	}
+}
+
+// getDatabaseHost DB_HOSTに設定する接続先を取得（RDS Proxy有効時はProxyのエンドポイント）
+func getDatabaseHost(props *ApplicationStackProps, dbConfig *config.DatabaseConfig) *string {
+	if !dbConfig.Proxy.Enabled {
+		if props.TestEnvFlag {
+			return jsii.String("mock-aurora-endpoint.cluster-xyz.rds.amazonaws.com")
+		}
+		return jsii.String(props.DatabaseEndpoint)
+	}
+
+	envConfig, err := config.GetEnvironmentConfig(props.Environment)
+	if err != nil {
+		panic("Invalid environment: " + props.Environment)
+	}
+
+	if props.TestEnvFlag {
+		// テスト環境では固定のProxyエンドポイント
+		return jsii.String("service-" + envConfig.Name + "-db-proxy.proxy-xyz.ap-northeast-1.rds.amazonaws.com")
+	}
+
+	// 実環境ではStorageStackのExportを参照
+	return awscdk.Fn_ImportValue(jsii.String("service-" + envConfig.Name + "-DB-Proxy-Endpoint"))
+}
+
+// createSecretsConfiguration シークレット設定を作成（StorageStackのDB認証情報を参照）
+// シークレットがCMKで暗号化されている場合はExecution Roleにキーの復号権限も付与される
+func createSecretsConfiguration(stack awscdk.Stack, props *ApplicationStackProps, encryptionKey awskms.IKey) map[string]awsecs.Secret {
+	secrets := make(map[string]awsecs.Secret)
+
+	dbSecret := awssecretsmanager.Secret_FromSecretAttributes(stack, jsii.String("DatabaseSecret"), &awssecretsmanager.SecretAttributes{
+		SecretCompleteArn: getDatabaseSecretArn(props),
+		EncryptionKey:     encryptionKey,
+	})
+
+	// Execution Roleにはこのシークレットの読み取り権限のみが付与される
+	secrets["DB_USERNAME"] = awsecs.Secret_FromSecretsManager(dbSecret, jsii.String("username"))
+	secrets["DB_PASSWORD"] = awsecs.Secret_FromSecretsManager(dbSecret, jsii.String("password"))
+
+	// Redisの認証情報（AUTHトークンの場合はusernameがdefault）
+	redisSecret := awssecretsmanager.Secret_FromSecretAttributes(stack, jsii.String("RedisSecret"), &awssecretsmanager.SecretAttributes{
+		SecretCompleteArn: getRedisSecretArn(props),
+		EncryptionKey:     encryptionKey,
+	})
+	secrets["REDIS_USERNAME"] = awsecs.Secret_FromSecretsManager(redisSecret, jsii.String("username"))
+	secrets["REDIS_PASSWORD"] = awsecs.Secret_FromSecretsManager(redisSecret, jsii.String("password"))
 
 	return secrets
 }
 
+// getRedisSecretArn Redis認証情報のシークレットARNを取得（テスト環境対応）
+func getRedisSecretArn(props *ApplicationStackProps) *string {
+	if props.RedisSecretArn != "" {
+		return jsii.String(props.RedisSecretArn)
+	}
+
+	envConfig, err := config.GetEnvironmentConfig(props.Environment)
+	if err != nil {
+		panic("Invalid environment: " + props.Environment)
+	}
+
+	if props.TestEnvFlag {
+		// テスト環境では固定のシークレットARN
+		return jsii.String("arn:aws:secretsmanager:ap-northeast-1:123456789012:secret:service-" + envConfig.Name + "-redis-credentials-GhIjKl")
+	}
+
+	// 実環境ではStorageStackのExportを参照
+	return awscdk.Fn_ImportValue(jsii.String("service-" + envConfig.Name + "-Redis-Secret-Arn"))
+}
+
+// getDatabaseSecretArn DB認証情報のシークレットARNを取得（テスト環境対応）
+func getDatabaseSecretArn(props *ApplicationStackProps) *string {
+	if props.DatabaseSecretArn != "" {
+		return jsii.String(props.DatabaseSecretArn)
+	}
+
+	envConfig, err := config.GetEnvironmentConfig(props.Environment)
+	if err != nil {
+		panic("Invalid environment: " + props.Environment)
+	}
+
+	if props.TestEnvFlag {
+		// テスト環境では固定のシークレットARN
+		return jsii.String("arn:aws:secretsmanager:ap-northeast-1:123456789012:secret:service-" + envConfig.Name + "-db-credentials-AbCdEf")
+	}
+
+	// 実環境ではStorageStackのExportを参照
+	return awscdk.Fn_ImportValue(jsii.String("service-" + envConfig.Name + "-DB-Secret-Arn"))
+}
+
 // createContainerDefinitions Container Definitionsを作成
 func createContainerDefinitions(
 	stack awscdk.Stack,
@@ -366,10 +573,13 @@ func createContainerDefinitions(
 	ecsConfig *config.ECSConfig,
 	ecrRepository awsecr.Repository,
 	props *ApplicationStackProps,
+	dataKeys *networkConstruct.DataKeys,
+	restrictEgress bool,
 ) {
 	// CloudWatch Log Group作成
 	logGroup := awslogs.NewLogGroup(stack, jsii.String("ServiceLogGroup"), &awslogs.LogGroupProps{
-		LogGroupName: jsii.String("/ecs/service-" + props.Environment),
+		LogGroupName:  jsii.String("/ecs/service-" + props.Environment),
+		EncryptionKey: dataKeys.Key(config.DataClassLogs),
 		Retention: func() awslogs.RetentionDays {
 			switch props.Environment {
 			case "prod":
@@ -387,24 +597,19 @@ func createContainerDefinitions(
 	environment := createEnvironmentVariables(props)
 
 	// Secrets設定（機密情報用）
-	secrets := createSecretsConfiguration(stack, props)
+	secrets := createSecretsConfiguration(stack, props, dataKeys.Key(config.DataClassSecrets))
 
 	// Nginxコンテナ（サイドカー）
 	nginxContainer := taskDefinition.AddContainer(jsii.String("nginx-web"), &awsecs.ContainerDefinitionOptions{
 		ContainerName:        jsii.String("nginx-web"),
-		Image:                awsecs.ContainerImage_FromRegistry(jsii.String("nginx:1.24-alpine"), nil),
+		Image:                publicContainerImage(stack, "NginxImageRepository", taskDefinition, props.Environment, "nginx:1.24-alpine", restrictEgress),
 		MemoryReservationMiB: jsii.Number(ecsConfig.Memory * 40 / 100), // 40%をNginxに割り当て
 		Essential:            jsii.Bool(true),
 		Logging: awsecs.LogDrivers_AwsLogs(&awsecs.AwsLogDriverProps{
 			LogGroup:     logGroup,
 			StreamPrefix: jsii.String("nginx"),
 		}),
-		PortMappings: &[]*awsecs.PortMapping{
-			{
-				ContainerPort: jsii.Number(80),
-				Protocol:      awsecs.Protocol_TCP,
-			},
-		},
+		PortMappings: getPortMappings(props.Environment, "nginx-web"),
 	})
 
 	// PHPアプリケーションコンテナ
@@ -437,6 +642,145 @@ func createContainerDefinitions(
 		Container: phpContainer,
 		Condition: awsecs.ContainerDependencyCondition_HEALTHY,
 	})
+
+	// タスク間で共有するEFS（有効な場合のみ）
+	fileSystemConfig := getApplicationFileSystemConfig(props)
+	if fileSystemConfig.Enabled {
+		addFileSystemVolumes(stack, taskDefinition, fileSystemConfig, phpContainer, nginxContainer, props)
+	}
+}
+
+// getApplicationFileSystemConfig マウントするEFS設定を取得（プロパティの指定を反映）
+func getApplicationFileSystemConfig(props *ApplicationStackProps) *config.FileSystemConfig {
+	fileSystemConfig := config.GetFileSystemConfig(props.Environment)
+	if props.FileSystem != nil {
+		fileSystemConfig = *props.FileSystem
+	}
+	if err := config.ValidateFileSystemConfig(fileSystemConfig); err != nil {
+		panic("Invalid file system configuration: " + err.Error())
+	}
+	return &fileSystemConfig
+}
+
+// addFileSystemVolumes StorageStackのEFSをアクセスポイントごとのボリュームとしてマウント
+// php-appは読み書き、nginx-webはWebReadOnlyのアクセスポイントのみ読み取り専用でマウント
+func addFileSystemVolumes(
+	stack awscdk.Stack,
+	taskDefinition awsecs.FargateTaskDefinition,
+	fileSystemConfig *config.FileSystemConfig,
+	phpContainer awsecs.ContainerDefinition,
+	nginxContainer awsecs.ContainerDefinition,
+	props *ApplicationStackProps,
+) {
+	envConfig, err := config.GetEnvironmentConfig(props.Environment)
+	if err != nil {
+		panic("Invalid environment: " + props.Environment)
+	}
+
+	fileSystemId := getFileSystemId(props, envConfig)
+
+	for _, ap := range fileSystemConfig.AccessPoints {
+		volumeName := jsii.String("efs-" + ap.Name)
+
+		// 転送時の暗号化とIAM認可を有効化（アクセスポイントでディレクトリとPOSIXユーザーを固定）
+		taskDefinition.AddVolume(&awsecs.Volume{
+			Name: volumeName,
+			EfsVolumeConfiguration: &awsecs.EfsVolumeConfiguration{
+				FileSystemId:      fileSystemId,
+				TransitEncryption: jsii.String("ENABLED"),
+				AuthorizationConfig: &awsecs.AuthorizationConfig{
+					AccessPointId: getFileSystemAccessPointId(props, envConfig, ap.Name),
+					Iam:           jsii.String("ENABLED"),
+				},
+			},
+		})
+
+		phpContainer.AddMountPoints(&awsecs.MountPoint{
+			ContainerPath: jsii.String(ap.ContainerPath),
+			SourceVolume:  volumeName,
+			ReadOnly:      jsii.Bool(false),
+		})
+		if ap.WebReadOnly {
+			nginxContainer.AddMountPoints(&awsecs.MountPoint{
+				ContainerPath: jsii.String(ap.ContainerPath),
+				SourceVolume:  volumeName,
+				ReadOnly:      jsii.Bool(true),
+			})
+		}
+	}
+
+	// ファイルシステムポリシーで匿名アクセスは拒否されるため、Task Roleにマウントターゲット経由の読み書きを許可
+	taskDefinition.TaskRole().AddToPrincipalPolicy(awsiam.NewPolicyStatement(&awsiam.PolicyStatementProps{
+		Effect: awsiam.Effect_ALLOW,
+		Actions: &[]*string{
+			jsii.String("elasticfilesystem:ClientMount"),
+			jsii.String("elasticfilesystem:ClientWrite"),
+		},
+		Resources: &[]*string{
+			stack.FormatArn(&awscdk.ArnComponents{
+				Service:      jsii.String("elasticfilesystem"),
+				Resource:     jsii.String("file-system"),
+				ResourceName: fileSystemId,
+			}),
+		},
+		Conditions: &map[string]interface{}{
+			"Bool": map[string]interface{}{
+				"elasticfilesystem:AccessedViaMountTarget": "true",
+			},
+		},
+	}))
+}
+
+// getFileSystemId EFSのファイルシステムIDを取得（テスト環境対応）
+func getFileSystemId(props *ApplicationStackProps, envConfig *config.EnvironmentConfig) *string {
+	if props.TestEnvFlag {
+		// テスト環境では固定のファイルシステムID
+		return jsii.String("fs-test-" + props.Environment)
+	}
+
+	// 実環境ではStorageStackのExportを参照
+	return awscdk.Fn_ImportValue(jsii.String(fileSystemExportName(envConfig)))
+}
+
+// getFileSystemAccessPointId EFSのアクセスポイントIDを取得（テスト環境対応）
+func getFileSystemAccessPointId(props *ApplicationStackProps, envConfig *config.EnvironmentConfig, name string) *string {
+	if props.TestEnvFlag {
+		// テスト環境では固定のアクセスポイントID
+		return jsii.String("fsap-test-" + name + "-" + props.Environment)
+	}
+
+	// 実環境ではStorageStackのExportを参照
+	return awscdk.Fn_ImportValue(jsii.String(fileSystemAccessPointExportName(envConfig, name)))
+}
+
+// getPortMappings コンテナのポートマッピングを設定から作成
+func getPortMappings(environment string, containerName string) *[]*awsecs.PortMapping {
+	mappings := []*awsecs.PortMapping{}
+	for _, p := range config.GetContainerPortConfigs(environment) {
+		if p.ContainerName != containerName {
+			continue
+		}
+		mappings = append(mappings, &awsecs.PortMapping{
+			ContainerPort: jsii.Number(p.ContainerPort),
+			Protocol: func() awsecs.Protocol {
+				if p.Protocol == "udp" {
+					return awsecs.Protocol_UDP
+				}
+				return awsecs.Protocol_TCP
+			}(),
+		})
+	}
+	return &mappings
+}
+
+// getLoadBalancedPort ALBのターゲットとなるポート設定を取得
+func getLoadBalancedPort(environment string) config.ContainerPortConfig {
+	for _, p := range config.GetContainerPortConfigs(environment) {
+		if p.LoadBalanced {
+			return p
+		}
+	}
+	panic("No load balanced container port configured for environment: " + environment)
 }
 
 // createEnvironmentVariables 環境変数設定を作成
@@ -455,24 +799,59 @@ func createEnvironmentVariables(props *ApplicationStackProps) map[string]*string
 		}
 	}()
 
-	environment["DB_CONNECTION"] = jsii.String("mysql")
+	// データベース接続設定はAuroraのエンジンに合わせる
+	dbConfig := getApplicationDatabaseConfig(props)
+	environment["DB_CONNECTION"] = jsii.String(dbConfig.ConnectionName())
+	environment["DB_PORT"] = jsii.String(strconv.Itoa(dbConfig.Port))
 	environment["CACHE_DRIVER"] = jsii.String("redis")
 	environment["AWS_DEFAULT_REGION"] = jsii.String("ap-northeast-1")
 
+	// RDS ProxyのIAM認証時はパスワードの代わりに認証トークンを生成する
+	if dbConfig.Proxy.Enabled && dbConfig.Proxy.IAMAuth {
+		environment["DB_IAM_AUTH"] = jsii.String("true")
+	}
+
 	// データベース・キャッシュエンドポイント（非機密情報）
+	environment["DB_HOST"] = getDatabaseHost(props, dbConfig)
 	if !props.TestEnvFlag {
 		// 実環境ではCross-stack参照
-		environment["DB_HOST"] = jsii.String(props.DatabaseEndpoint)
 		environment["REDIS_HOST"] = jsii.String(props.RedisEndpoint)
 	} else {
 		// テスト環境では固定値
-		environment["DB_HOST"] = jsii.String("mock-aurora-endpoint.cluster-xyz.rds.amazonaws.com")
 		environment["REDIS_HOST"] = jsii.String("mock-redis-endpoint.cache.amazonaws.com")
 	}
 
+	// 静的アセットの配信URL（CloudFront有効時のみ）
+	if assetUrl := getStaticAssetsUrl(props); assetUrl != nil {
+		environment["ASSET_URL"] = assetUrl
+	}
+
 	return environment
 }
 
+// getStaticAssetsUrl 静的アセットのURLを取得（CloudFront無効時はnil、テスト環境対応）
+func getStaticAssetsUrl(props *ApplicationStackProps) *string {
+	if props.StaticAssetsUrl != "" {
+		return jsii.String(props.StaticAssetsUrl)
+	}
+	if !config.GetCDNConfig(props.Environment).Enabled {
+		return nil
+	}
+
+	envConfig, err := config.GetEnvironmentConfig(props.Environment)
+	if err != nil {
+		panic("Invalid environment: " + props.Environment)
+	}
+
+	if props.TestEnvFlag {
+		// テスト環境では固定のURL
+		return jsii.String("https://d111111abcdef8.cloudfront.net")
+	}
+
+	// 実環境ではStorageStackのExportを参照
+	return awscdk.Fn_ImportValue(jsii.String("service-" + envConfig.Name + "-Static-Assets-Url"))
+}
+
 // createECSServiceWithALB ECS ServiceとALBを統合して作成（推奨版）
 func createECSServiceWithALB(
 	stack awscdk.Stack,
@@ -482,6 +861,7 @@ func createECSServiceWithALB(
 	ecsConfig *config.ECSConfig,
 	vpc awsec2.IVpc,
 	environment string,
+	securityGroups *networkConstruct.ServiceSecurityGroups,
 ) (awsecs.FargateService, awselasticloadbalancingv2.ApplicationTargetGroup) {
 
 	// 1. 最初にECS Serviceを作成
@@ -499,7 +879,7 @@ func createECSServiceWithALB(
 
 		// セキュリティグループ設定
 		SecurityGroups: &[]awsec2.ISecurityGroup{
-			getECSSecurityGroup(stack, environment),
+			securityGroups.SecurityGroup("ECS"),
 		},
 
 		// デプロイ設定
@@ -520,9 +900,16 @@ func createECSServiceWithALB(
 	})
 
 	// 2. Target Groupを作成（ECS Service用に最適化）
+	// ポート・ヘルスチェックポートはタスク定義のポートマッピングと共通設定（SGルールも同じ設定から生成）
+	targetPort := getLoadBalancedPort(environment)
+	healthCheckPort := targetPort.ContainerPort
+	if targetPort.HealthCheckPort != 0 {
+		healthCheckPort = targetPort.HealthCheckPort
+	}
+
 	targetGroup := awselasticloadbalancingv2.NewApplicationTargetGroup(stack, jsii.String("ServiceTargetGroup"), &awselasticloadbalancingv2.ApplicationTargetGroupProps{
 		Vpc:             vpc,
-		Port:            jsii.Number(80),
+		Port:            jsii.Number(targetPort.ContainerPort),
 		Protocol:        awselasticloadbalancingv2.ApplicationProtocol_HTTP,
 		TargetType:      awselasticloadbalancingv2.TargetType_IP, // Fargate必須
 		TargetGroupName: jsii.String("service-" + environment + "-tg"),
@@ -530,7 +917,7 @@ func createECSServiceWithALB(
 		// ヘルスチェック設定（重要）
 		HealthCheck: &awselasticloadbalancingv2.HealthCheck{
 			Path:     jsii.String("/health"),
-			Port:     jsii.String("80"),
+			Port:     jsii.String(strconv.Itoa(healthCheckPort)),
 			Protocol: awselasticloadbalancingv2.Protocol_HTTP,
 
 			// タイムアウト設定
@@ -544,19 +931,57 @@ func createECSServiceWithALB(
 		},
 	})
 
-	// 3. ALBにListenerを追加（Target Group統合）
+	// 3. ECS ServiceをTarget Groupに関連付け（ListenerはaddALBListenersで追加）
+	service.AttachToApplicationTargetGroup(targetGroup)
+
+	return service, targetGroup
+}
+
+// addALBListeners ALBにListenerを追加（Target Group統合）
+// 公開範囲はNetworkStackのALB公開ポリシーで管理するため、0.0.0.0/0は自動追加しない
+func addALBListeners(
+	stack awscdk.Stack,
+	alb awselasticloadbalancingv2.ApplicationLoadBalancer,
+	targetGroup awselasticloadbalancingv2.ApplicationTargetGroup,
+	albExposure string,
+	albCertificate string,
+) {
+	if albCertificate != "" {
+		alb.AddListener(jsii.String("HTTPSListener"), &awselasticloadbalancingv2.BaseApplicationListenerProps{
+			Port:         jsii.Number(443),
+			Protocol:     awselasticloadbalancingv2.ApplicationProtocol_HTTPS,
+			Open:         jsii.Bool(false),
+			SslPolicy:    awselasticloadbalancingv2.SslPolicy_RECOMMENDED_TLS,
+			Certificates: &[]awselasticloadbalancingv2.IListenerCertificate{awselasticloadbalancingv2.ListenerCertificate_FromArn(jsii.String(albCertificate))},
+			DefaultTargetGroups: &[]awselasticloadbalancingv2.IApplicationTargetGroup{
+				targetGroup,
+			},
+		})
+	}
+
+	// HTTPはHTTPSへリダイレクト（https-onlyの場合は80がセキュリティグループで閉じている）
+	if config.RedirectsALBHTTPToHTTPS(albExposure, albCertificate) {
+		alb.AddListener(jsii.String("HTTPListener"), &awselasticloadbalancingv2.BaseApplicationListenerProps{
+			Port:     jsii.Number(80),
+			Protocol: awselasticloadbalancingv2.ApplicationProtocol_HTTP,
+			Open:     jsii.Bool(false),
+			DefaultAction: awselasticloadbalancingv2.ListenerAction_Redirect(&awselasticloadbalancingv2.RedirectOptions{
+				Protocol:  jsii.String("HTTPS"),
+				Port:      jsii.String("443"),
+				Permanent: jsii.Bool(true),
+			}),
+		})
+		return
+	}
+
 	alb.AddListener(jsii.String("HTTPListener"), &awselasticloadbalancingv2.BaseApplicationListenerProps{
 		Port:     jsii.Number(80),
 		Protocol: awselasticloadbalancingv2.ApplicationProtocol_HTTP,
+		Open:     jsii.Bool(false),
 		DefaultTargetGroups: &[]awselasticloadbalancingv2.IApplicationTargetGroup{
 			targetGroup,
 		},
 	})
-
-	// 4. ECS ServiceをTarget Groupに関連付け
-	service.AttachToApplicationTargetGroup(targetGroup)
-
-	return service, targetGroup
 }
 
 // createCapacityProviderStrategies Capacity Provider戦略を作成
@@ -585,24 +1010,6 @@ func createCapacityProviderStrategies(ecsConfig *config.ECSConfig) *[]*awsecs.Ca
 	}
 }
 
-// getECSSecurityGroup ECS用セキュリティグループを取得（Cross-stack参照）
-func getECSSecurityGroup(stack awscdk.Stack, environment string) awsec2.ISecurityGroup {
-	envName := environment
-	if envName == "prod" {
-		envName = "production"
-	}
-
-	// NetworkStackからセキュリティグループIDをインポート
-	sgId := awscdk.Fn_ImportValue(jsii.String("Service-" + envName + "-ECS-SG-Id"))
-
-	return awsec2.SecurityGroup_FromSecurityGroupId(
-		stack,
-		jsii.String("ImportedECSSecurityGroup"),
-		sgId,
-		nil,
-	)
-}
-
 // createServiceDiscovery Service Discoveryを作成（本番環境用）
 func createServiceDiscovery(
 	stack awscdk.Stack,
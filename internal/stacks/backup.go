@@ -0,0 +1,226 @@
+package stacks
+
+import (
+	"aws-ecs-fargate-go-cdk/internal/config"
+
+	"github.com/aws/aws-cdk-go/awscdk/v2"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awsbackup"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awsevents"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awsiam"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awskms"
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+)
+
+// BackupStackProps BackupStackのプロパティ
+type BackupStackProps struct {
+	awscdk.StackProps
+	Environment string
+
+	// AWS Backup設定（未指定の場合は環境設定を使用）
+	Backup *config.BackupConfig
+
+	// trueの場合はクロスリージョンコピー先のボールトのみを作成（コピー先リージョンにデプロイ）
+	CopyDestination bool
+
+	// データ分類別のKMSキー設定（未指定の場合は環境設定を使用、KeyStackと同じ設定にすること）
+	Encryption *config.EncryptionConfig
+}
+
+// BackupStack BackupStackの構造体
+type BackupStack struct {
+	awscdk.Stack
+	VaultKey awskms.IKey
+	Vault    awsbackup.BackupVault
+	Plan     awsbackup.BackupPlan // コピー先の場合はnil
+}
+
+// NewBackupStack BackupStackを作成
+// EnvironmentConfig.TagsでBackup: Requiredタグが付与されたリソース（Aurora・S3など）をバックアップ対象とする
+func NewBackupStack(scope constructs.Construct, id string, props *BackupStackProps) awscdk.Stack {
+	var sprops awscdk.StackProps
+	if props != nil {
+		sprops = props.StackProps
+	}
+	stack := awscdk.NewStack(scope, &id, &sprops)
+
+	// プロパティバリデーション
+	if props == nil || props.Environment == "" {
+		panic("BackupStackProps with Environment is required")
+	}
+
+	// 環境設定を取得
+	envConfig, err := config.GetEnvironmentConfig(props.Environment)
+	if err != nil {
+		panic("Invalid environment: " + props.Environment)
+	}
+
+	backupConfig := props.Backup
+	if backupConfig == nil {
+		c := config.GetBackupConfig(props.Environment)
+		backupConfig = &c
+	}
+	if err := config.ValidateBackupConfig(*backupConfig); err != nil {
+		panic("Invalid backup configuration: " + err.Error())
+	}
+	if !backupConfig.Enabled {
+		panic("AWS Backup is not enabled for environment: " + props.Environment)
+	}
+
+	backupStack := &BackupStack{Stack: stack}
+
+	// ボールト（コピー先も同名・同じロック設定）
+	// バックアップのCMKを使用しない場合はボールト専用のキーを作成
+	dataKeys := importDataKeys(stack, props.Environment, props.Encryption, false)
+	backupStack.VaultKey = dataKeys.Key(config.DataClassBackups)
+	if backupStack.VaultKey == nil {
+		backupStack.VaultKey = createBackupVaultKey(stack, envConfig)
+	}
+	backupStack.Vault = createBackupVault(stack, envConfig, backupConfig, backupStack.VaultKey)
+
+	if !props.CopyDestination {
+		backupStack.Plan = createBackupPlan(stack, envConfig, backupConfig, backupStack.Vault)
+	}
+
+	createBackupStackOutputs(backupStack, envConfig)
+
+	addBackupStackTags(stack, envConfig)
+
+	return stack
+}
+
+// backupVaultName バックアップボールト名（クロスリージョンコピー先と共通）
+func backupVaultName(envConfig *config.EnvironmentConfig) string {
+	return "service-" + envConfig.Name + "-backup-vault"
+}
+
+// createBackupVaultKey ボールト専用のKMSキーを作成
+func createBackupVaultKey(stack awscdk.Stack, envConfig *config.EnvironmentConfig) awskms.IKey {
+	return awskms.NewKey(stack, jsii.String("BackupVaultKey"), &awskms.KeyProps{
+		Alias:             jsii.String("alias/service-" + envConfig.Name + "-backup"),
+		Description:       jsii.String("AWS Backup vault encryption key for service-" + envConfig.Name),
+		EnableKeyRotation: jsii.Bool(true),
+		RemovalPolicy:     awscdk.RemovalPolicy_RETAIN,
+	})
+}
+
+// createBackupVault KMSキーで暗号化したバックアップボールトを作成
+func createBackupVault(stack awscdk.Stack, envConfig *config.EnvironmentConfig, backupConfig *config.BackupConfig, key awskms.IKey) awsbackup.BackupVault {
+	return awsbackup.NewBackupVault(stack, jsii.String("BackupVault"), &awsbackup.BackupVaultProps{
+		BackupVaultName:   jsii.String(backupVaultName(envConfig)),
+		EncryptionKey:     key,
+		LockConfiguration: toVaultLockConfiguration(backupConfig.VaultLock),
+		RemovalPolicy:     awscdk.RemovalPolicy_RETAIN,
+	})
+}
+
+// toVaultLockConfiguration ロック設定をボールトのロック設定に変換（無効の場合はnil）
+func toVaultLockConfiguration(lock config.BackupVaultLockConfig) *awsbackup.LockConfiguration {
+	if !lock.Enabled {
+		return nil
+	}
+
+	lockConfiguration := &awsbackup.LockConfiguration{
+		MinRetention: awscdk.Duration_Days(jsii.Number(lock.MinRetentionDays)),
+	}
+	if lock.MaxRetentionDays > 0 {
+		lockConfiguration.MaxRetention = awscdk.Duration_Days(jsii.Number(lock.MaxRetentionDays))
+	}
+	// 猶予期間の経過後はコンプライアンスモードとなりロックを解除できない
+	if lock.ChangeableForDays > 0 {
+		lockConfiguration.ChangeableFor = awscdk.Duration_Days(jsii.Number(lock.ChangeableForDays))
+	}
+	return lockConfiguration
+}
+
+// createBackupPlan バックアッププランを作成し、タグでバックアップ対象を選択
+func createBackupPlan(stack awscdk.Stack, envConfig *config.EnvironmentConfig, backupConfig *config.BackupConfig, vault awsbackup.IBackupVault) awsbackup.BackupPlan {
+	plan := awsbackup.NewBackupPlan(stack, jsii.String("BackupPlan"), &awsbackup.BackupPlanProps{
+		BackupPlanName: jsii.String("service-" + envConfig.Name + "-backup-plan"),
+		BackupVault:    vault,
+	})
+
+	// クロスリージョンコピー先のボールト（コピー先リージョンのBackupStackで作成）
+	var copyVault awsbackup.IBackupVault
+	if backupConfig.CopyRegion != "" {
+		copyVault = awsbackup.BackupVault_FromBackupVaultArn(stack, jsii.String("CopyDestinationVault"),
+			awscdk.Fn_Sub(jsii.String("arn:${AWS::Partition}:backup:"+backupConfig.CopyRegion+":${AWS::AccountId}:backup-vault:"+backupVaultName(envConfig)), nil))
+	}
+
+	for _, r := range backupConfig.Rules {
+		plan.AddRule(toBackupPlanRule(r, copyVault))
+	}
+
+	// バックアップ・復元用のロール（S3はサービスロールのポリシーに含まれないため追加）
+	role := awsiam.NewRole(stack, jsii.String("BackupRole"), &awsiam.RoleProps{
+		RoleName:  jsii.String("service-" + envConfig.Name + "-backup"),
+		AssumedBy: awsiam.NewServicePrincipal(jsii.String("backup.amazonaws.com"), nil),
+		ManagedPolicies: &[]awsiam.IManagedPolicy{
+			awsiam.ManagedPolicy_FromAwsManagedPolicyName(jsii.String("AWSBackupServiceRolePolicyForS3Backup")),
+			awsiam.ManagedPolicy_FromAwsManagedPolicyName(jsii.String("AWSBackupServiceRolePolicyForS3Restore")),
+		},
+	})
+
+	plan.AddSelection(jsii.String("TaggedResources"), &awsbackup.BackupSelectionOptions{
+		BackupSelectionName: jsii.String("service-" + envConfig.Name + "-tagged-resources"),
+		Resources: &[]awsbackup.BackupResource{
+			awsbackup.BackupResource_FromTag(jsii.String(config.BackupSelectionTagKey), jsii.String(config.BackupSelectionTagValue), awsbackup.TagOperation_STRING_EQUALS),
+		},
+		Role:          role,
+		AllowRestores: jsii.Bool(true),
+	})
+
+	return plan
+}
+
+// toBackupPlanRule ルール設定をバックアッププランのルールに変換
+func toBackupPlanRule(r config.BackupRuleConfig, copyVault awsbackup.IBackupVault) awsbackup.BackupPlanRule {
+	props := &awsbackup.BackupPlanRuleProps{
+		RuleName:           jsii.String(r.Name),
+		ScheduleExpression: awsevents.Schedule_Expression(jsii.String(r.Schedule)),
+		DeleteAfter:        awscdk.Duration_Days(jsii.Number(r.DeleteAfterDays)),
+	}
+	if r.MoveToColdStorageAfterDays > 0 {
+		props.MoveToColdStorageAfter = awscdk.Duration_Days(jsii.Number(r.MoveToColdStorageAfterDays))
+	}
+	if r.CopyDeleteAfterDays > 0 {
+		props.CopyActions = &[]*awsbackup.BackupPlanCopyActionProps{
+			{
+				DestinationBackupVault: copyVault,
+				DeleteAfter:            awscdk.Duration_Days(jsii.Number(r.CopyDeleteAfterDays)),
+			},
+		}
+	}
+	return awsbackup.NewBackupPlanRule(props)
+}
+
+// createBackupStackOutputs Cross-stack出力を作成
+func createBackupStackOutputs(backupStack *BackupStack, envConfig *config.EnvironmentConfig) {
+	awscdk.NewCfnOutput(backupStack.Stack, jsii.String("BackupVaultName"), &awscdk.CfnOutputProps{
+		Value:       backupStack.Vault.BackupVaultName(),
+		Description: jsii.String("AWS Backup Vault Name"),
+		ExportName:  jsii.String("service-" + envConfig.Name + "-Backup-Vault-Name"),
+	})
+	awscdk.NewCfnOutput(backupStack.Stack, jsii.String("BackupVaultArn"), &awscdk.CfnOutputProps{
+		Value:       backupStack.Vault.BackupVaultArn(),
+		Description: jsii.String("AWS Backup Vault ARN"),
+		ExportName:  jsii.String("service-" + envConfig.Name + "-Backup-Vault-Arn"),
+	})
+
+	if backupStack.Plan != nil {
+		awscdk.NewCfnOutput(backupStack.Stack, jsii.String("BackupPlanId"), &awscdk.CfnOutputProps{
+			Value:       backupStack.Plan.BackupPlanId(),
+			Description: jsii.String("AWS Backup Plan ID"),
+			ExportName:  jsii.String("service-" + envConfig.Name + "-Backup-Plan-Id"),
+		})
+	}
+}
+
+// addBackupStackTags BackupStackにタグを追加
+func addBackupStackTags(stack awscdk.Stack, envConfig *config.EnvironmentConfig) {
+	for key, value := range envConfig.Tags {
+		awscdk.Tags_Of(stack).Add(jsii.String(key), jsii.String(value), nil)
+	}
+	awscdk.Tags_Of(stack).Add(jsii.String("StackType"), jsii.String("Backup"), nil)
+	awscdk.Tags_Of(stack).Add(jsii.String("ManagedBy"), jsii.String("CDK"), nil)
+}
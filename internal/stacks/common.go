@@ -1,8 +1,16 @@
 package stacks
 
 import (
+	"aws-ecs-fargate-go-cdk/internal/config"
+	networkConstruct "aws-ecs-fargate-go-cdk/internal/constructs"
+
 	"github.com/aws/aws-cdk-go/awscdk/v2"
 	"github.com/aws/aws-cdk-go/awscdk/v2/awsec2"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awsecr"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awsecs"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awsiam"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awskms"
+	"github.com/aws/constructs-go/constructs/v10"
 	"github.com/aws/jsii-runtime-go"
 )
 
@@ -125,3 +133,66 @@ func createVPCFromCrossStackReference(stack awscdk.Stack, envName string) awsec2
 		},
 	})
 }
+
+// resolveSecurityMatrix セキュリティグループ定義を取得（未指定の場合は環境設定から作成）
+// 定義を指定した場合はEgress制限も定義のrestrictEgressに合わせる（NetworkStackと同じ判定）
+func resolveSecurityMatrix(environment string, matrix *config.SecurityMatrixConfig, envConfig *config.EnvironmentConfig) *config.SecurityMatrixConfig {
+	if matrix == nil {
+		return config.BuildSecurityMatrixConfig(environment, config.SecurityMatrixOptions{
+			ALBExposure:    envConfig.ALBExposure,
+			AllowedCIDRs:   envConfig.RestrictedCIDRs,
+			RestrictEgress: envConfig.RestrictEgress,
+		})
+	}
+	if err := config.ValidateSecurityMatrixConfig(matrix); err != nil {
+		panic("Invalid security matrix: " + err.Error())
+	}
+	envConfig.RestrictEgress = matrix.RestrictEgress
+	return matrix
+}
+
+// importDataKeys KeyStackのデータ分類別キーを参照（テスト環境では固定のARN）
+func importDataKeys(stack awscdk.Stack, environment string, encryption *config.EncryptionConfig, isTestEnvironment bool) *networkConstruct.DataKeys {
+	keyArns := map[string]string{}
+	if isTestEnvironment {
+		for _, dataClass := range config.DataClasses() {
+			keyArns[dataClass] = "arn:aws:kms:ap-northeast-1:123456789012:key/test-" + dataClass + "-" + environment
+		}
+	}
+
+	return networkConstruct.ImportDataKeys(stack, "ImportedDataKeys", &networkConstruct.DataKeysImportProps{
+		Environment: environment,
+		Config:      encryption,
+		KeyArns:     keyArns,
+	})
+}
+
+// keyArn キーのARN（CloudFormationのKmsKeyIdに指定、キーがない場合はnil）
+func keyArn(key awskms.IKey) *string {
+	if key == nil {
+		return nil
+	}
+	return key.KeyArn()
+}
+
+// publicContainerImage パブリックレジストリのコンテナイメージを取得
+// Egress制限時はインターネットに出られないため、NetworkStackのプルスルーキャッシュからECRのVPCエンドポイント経由で取得
+func publicContainerImage(scope constructs.Construct, id string, taskDefinition awsecs.TaskDefinition, environment string, image string, restrictEgress bool) awsecs.ContainerImage {
+	if !restrictEgress {
+		return awsecs.ContainerImage_FromRegistry(jsii.String(image), nil)
+	}
+
+	repositoryName, tag, err := config.ParsePublicImage(image)
+	if err != nil {
+		panic("Invalid public image: " + err.Error())
+	}
+	repository := awsecr.Repository_FromRepositoryName(scope, jsii.String(id), jsii.String(config.PullThroughCachePrefix(environment)+"/"+repositoryName))
+
+	// 初回取得時にキャッシュ用のリポジトリを作成してイメージを取り込む権限（取得権限はFromEcrRepositoryで付与）
+	taskDefinition.ObtainExecutionRole().AddToPrincipalPolicy(awsiam.NewPolicyStatement(&awsiam.PolicyStatementProps{
+		Actions:   jsii.Strings("ecr:CreateRepository", "ecr:BatchImportUpstreamImage"),
+		Resources: jsii.Strings(*repository.RepositoryArn()),
+	}))
+
+	return awsecs.ContainerImage_FromEcrRepository(repository, jsii.String(tag))
+}
@@ -0,0 +1,83 @@
+package stacks
+
+import (
+	"aws-ecs-fargate-go-cdk/internal/config"
+	networkConstruct "aws-ecs-fargate-go-cdk/internal/constructs"
+	"strings"
+
+	"github.com/aws/aws-cdk-go/awscdk/v2"
+	"github.com/aws/constructs-go/constructs/v10"
+	"github.com/aws/jsii-runtime-go"
+)
+
+// KeyStackProps KeyStackのプロパティ
+type KeyStackProps struct {
+	awscdk.StackProps
+	Environment string
+
+	// 暗号化設定（未指定の場合は環境設定を使用）
+	Encryption *config.EncryptionConfig
+}
+
+// KeyStack KeyStackの構造体
+type KeyStack struct {
+	awscdk.Stack
+	DataKeys *networkConstruct.DataKeys
+}
+
+// NewKeyStack KeyStackを作成
+// データ分類別のカスタマー管理キーを作成し、他のStackが参照できるようARNをExport
+func NewKeyStack(scope constructs.Construct, id string, props *KeyStackProps) awscdk.Stack {
+	var sprops awscdk.StackProps
+	if props != nil {
+		sprops = props.StackProps
+	}
+	stack := awscdk.NewStack(scope, &id, &sprops)
+
+	// プロパティバリデーション
+	if props == nil || props.Environment == "" {
+		panic("KeyStackProps with Environment is required")
+	}
+
+	// 環境設定を取得
+	envConfig, err := config.GetEnvironmentConfig(props.Environment)
+	if err != nil {
+		panic("Invalid environment: " + props.Environment)
+	}
+
+	keyStack := &KeyStack{
+		Stack: stack,
+		DataKeys: networkConstruct.NewDataKeys(stack, "DataKeys", &networkConstruct.DataKeysProps{
+			Environment: props.Environment,
+			Config:      props.Encryption,
+			Name:        "service-" + envConfig.Name,
+		}),
+	}
+
+	// Cross-stack出力作成
+	for _, dataClass := range keyStack.DataKeys.Config.DataClasses {
+		awscdk.NewCfnOutput(stack, jsii.String(dataClassOutputId(dataClass)), &awscdk.CfnOutputProps{
+			Value:       keyStack.DataKeys.Key(dataClass).KeyArn(),
+			Description: jsii.String("KMS Key ARN for " + dataClass + " data"),
+			ExportName:  jsii.String(networkConstruct.DataKeyExportName(props.Environment, dataClass)),
+		})
+	}
+
+	addKeyStackTags(stack, envConfig)
+
+	return stack
+}
+
+// dataClassOutputId データ分類のキーARNの出力ID（DatabaseKeyArn など）
+func dataClassOutputId(dataClass string) string {
+	return strings.ToUpper(dataClass[:1]) + dataClass[1:] + "KeyArn"
+}
+
+// addKeyStackTags KeyStackにタグを追加
+func addKeyStackTags(stack awscdk.Stack, envConfig *config.EnvironmentConfig) {
+	for key, value := range envConfig.Tags {
+		awscdk.Tags_Of(stack).Add(jsii.String(key), jsii.String(value), nil)
+	}
+	awscdk.Tags_Of(stack).Add(jsii.String("StackType"), jsii.String("Key"), nil)
+	awscdk.Tags_Of(stack).Add(jsii.String("ManagedBy"), jsii.String("CDK"), nil)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-cdk-go/awscdk/v2"
 	"github.com/aws/aws-cdk-go/awscdk/v2/awsec2"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awsecr"
 	"github.com/aws/constructs-go/constructs/v10"
 	"github.com/aws/jsii-runtime-go"
 )
@@ -13,15 +14,19 @@ import (
 // NetworkStackProps NetworkStackのプロパティ
 type NetworkStackProps struct {
 	awscdk.StackProps
-	Environment string
-	VpcCidr     string
+	Environment    string
+	VpcCidr        string
+	ALBExposure    string                       // 未指定の場合は環境設定を使用
+	ALBCertificate string                       // 未指定の場合は環境設定を使用（ApplicationStackと同じ設定にすること）
+	SecurityMatrix *config.SecurityMatrixConfig // 未指定の場合は環境設定を使用（StorageStack・ApplicationStackと同じ設定にすること）
+	RestrictEgress bool                         // trueの場合、環境設定に関わらずEgressを制限（SecurityMatrix指定時は定義のrestrictEgressを使用）
 }
 
 // NetworkStack NetworkStackの構造体
 type NetworkStack struct {
 	awscdk.Stack
 	Vpc            awsec2.Vpc
-	SecurityGroups *networkConstruct.SecurityGroupsResult
+	SecurityGroups *networkConstruct.ServiceSecurityGroups
 	VpcIdOutput    awscdk.CfnOutput
 }
 
@@ -77,12 +82,49 @@ func NewNetworkStack(scope constructs.Construct, id string, props *NetworkStackP
 	// VPCにタグを追加
 	addVPCTags(vpc, envConfig)
 
+	// ALB公開ポリシーの設定（プロパティで指定されていない場合は環境設定を使用）
+	albExposure := props.ALBExposure
+	if albExposure == "" {
+		albExposure = envConfig.ALBExposure
+	}
+	albCertificate := props.ALBCertificate
+	if albCertificate == "" {
+		albCertificate = envConfig.ALBCertificate
+	}
+	if err := config.ValidateALBExposure(albExposure, envConfig.RestrictedCIDRs, albCertificate); err != nil {
+		panic("Invalid ALB exposure: " + err.Error())
+	}
+
+	// セキュリティグループ定義（プロパティで指定されていない場合は環境設定を使用）
+	// 定義を指定した場合はEgress制限も定義のrestrictEgressに従う（StorageStack・ApplicationStackも同じ判定）
+	securityMatrix := props.SecurityMatrix
+	if securityMatrix == nil {
+		securityMatrix = config.BuildSecurityMatrixConfig(props.Environment, config.SecurityMatrixOptions{
+			ALBExposure:    albExposure,
+			AllowedCIDRs:   envConfig.RestrictedCIDRs,
+			RestrictEgress: props.RestrictEgress || envConfig.RestrictEgress,
+		})
+	}
+
+	// ECSへのIngressがコンテナの待受ポートに対応しているか検証
+	if err := config.ValidateECSIngressRules(securityMatrix, config.GetContainerPortConfigs(props.Environment)); err != nil {
+		panic("Invalid ECS ingress rules: " + err.Error())
+	}
+
 	// セキュリティグループの作成
-	securityGroups := networkConstruct.CreateSecurityGroups(stack, &networkConstruct.SecurityGroupsProps{
+	securityGroups := networkConstruct.NewServiceSecurityGroups(stack, "SecurityGroups", &networkConstruct.ServiceSecurityGroupsProps{
 		Vpc:         vpc,
 		Environment: props.Environment,
+		Matrix:      securityMatrix,
 	})
 
+	// Egress制限時はAWSサービスへの通信をVPCエンドポイント経由にし、
+	// パブリックレジストリのイメージはECRのプルスルーキャッシュから取得
+	if securityMatrix.RestrictEgress {
+		createVpcEndpoints(vpc, securityGroups)
+		createPullThroughCacheRule(stack, props.Environment)
+	}
+
 	// Cross-stack出力の作成
 	createStackOutputs(stack, vpc, securityGroups, props.Environment)
 
@@ -128,8 +170,42 @@ func addVPCTags(vpc awsec2.Vpc, envConfig *config.EnvironmentConfig) {
 	awscdk.Tags_Of(vpc).Add(jsii.String("ManagedBy"), jsii.String("CDK"), nil)
 }
 
+// createVpcEndpoints ECSタスクが利用するAWSサービスのVPCエンドポイントを作成
+func createVpcEndpoints(vpc awsec2.Vpc, securityGroups *networkConstruct.ServiceSecurityGroups) {
+	if _, ok := securityGroups.Matrix.FindTier("Endpoints"); !ok {
+		panic("Endpoints tier is required when egress is restricted")
+	}
+	endpointSG := securityGroups.SecurityGroup("Endpoints")
+
+	// S3はゲートウェイ型（ECRのイメージレイヤー取得に使用）
+	vpc.AddGatewayEndpoint(jsii.String("S3Endpoint"), &awsec2.GatewayVpcEndpointOptions{
+		Service: awsec2.GatewayVpcEndpointAwsService_S3(),
+		Subnets: &[]*awsec2.SubnetSelection{
+			{SubnetType: awsec2.SubnetType_PRIVATE_WITH_EGRESS},
+		},
+	})
+
+	interfaceEndpoints := map[string]awsec2.InterfaceVpcEndpointAwsService{
+		"EcrEndpoint":            awsec2.InterfaceVpcEndpointAwsService_ECR(),
+		"EcrDockerEndpoint":      awsec2.InterfaceVpcEndpointAwsService_ECR_DOCKER(),
+		"LogsEndpoint":           awsec2.InterfaceVpcEndpointAwsService_CLOUDWATCH_LOGS(),
+		"SecretsManagerEndpoint": awsec2.InterfaceVpcEndpointAwsService_SECRETS_MANAGER(),
+	}
+	for _, id := range []string{"EcrEndpoint", "EcrDockerEndpoint", "LogsEndpoint", "SecretsManagerEndpoint"} {
+		vpc.AddInterfaceEndpoint(jsii.String(id), &awsec2.InterfaceVpcEndpointOptions{
+			Service:           interfaceEndpoints[id],
+			SecurityGroups:    &[]awsec2.ISecurityGroup{endpointSG},
+			Open:              jsii.Bool(false), // 許可はEndpointsティアのルールで管理
+			PrivateDnsEnabled: jsii.Bool(true),
+			Subnets: &awsec2.SubnetSelection{
+				SubnetType: awsec2.SubnetType_PRIVATE_WITH_EGRESS,
+			},
+		})
+	}
+}
+
 // createStackOutputs Cross-stack出力を作成
-func createStackOutputs(stack awscdk.Stack, vpc awsec2.Vpc, securityGroups *networkConstruct.SecurityGroupsResult, environment string) {
+func createStackOutputs(stack awscdk.Stack, vpc awsec2.Vpc, securityGroups *networkConstruct.ServiceSecurityGroups, environment string) {
 	// VPC ID出力
 	awscdk.NewCfnOutput(stack, jsii.String("VpcId"), &awscdk.CfnOutputProps{
 		Value:       vpc.VpcId(),
@@ -137,24 +213,14 @@ func createStackOutputs(stack awscdk.Stack, vpc awsec2.Vpc, securityGroups *netw
 		ExportName:  jsii.String("Service-" + environment + "-VpcId"),
 	})
 
-	// セキュリティグループID出力
-	awscdk.NewCfnOutput(stack, jsii.String("ALBSecurityGroupId"), &awscdk.CfnOutputProps{
-		Value:       securityGroups.ALBSecurityGroup.SecurityGroupId(),
-		Description: jsii.String("ALB Security Group ID"),
-		ExportName:  jsii.String("Service-" + environment + "-ALB-SG-Id"),
-	})
-
-	awscdk.NewCfnOutput(stack, jsii.String("ECSSecurityGroupId"), &awscdk.CfnOutputProps{
-		Value:       securityGroups.ECSSecurityGroup.SecurityGroupId(),
-		Description: jsii.String("ECS Security Group ID"),
-		ExportName:  jsii.String("Service-" + environment + "-ECS-SG-Id"),
-	})
-
-	awscdk.NewCfnOutput(stack, jsii.String("RDSSecurityGroupId"), &awscdk.CfnOutputProps{
-		Value:       securityGroups.RDSSecurityGroup.SecurityGroupId(),
-		Description: jsii.String("RDS Security Group ID"),
-		ExportName:  jsii.String("Service-" + environment + "-RDS-SG-Id"),
-	})
+	// セキュリティグループID出力（ティアごと）
+	for _, tier := range securityGroups.Matrix.Tiers {
+		awscdk.NewCfnOutput(stack, jsii.String(tier.Name+"SecurityGroupId"), &awscdk.CfnOutputProps{
+			Value:       securityGroups.SecurityGroup(tier.Name).SecurityGroupId(),
+			Description: jsii.String(tier.Name + " Security Group ID"),
+			ExportName:  jsii.String(networkConstruct.SecurityGroupExportName(environment, tier.Name)),
+		})
+	}
 
 	// サブネット出力（後のStackで使用）
 	privateSubnetIds := make([]*string, len(*vpc.PrivateSubnets()))
@@ -192,3 +258,12 @@ func createStackOutputs(stack awscdk.Stack, vpc awsec2.Vpc, securityGroups *netw
 		ExportName:  jsii.String("Service-" + environment + "-PrivateRouteTableIds"),
 	})
 }
+
+// createPullThroughCacheRule ECR Publicのプルスルーキャッシュを作成
+// インターネットへのEgressがなくても、ECRのVPCエンドポイント経由でパブリックイメージを取得できるようにする
+func createPullThroughCacheRule(stack awscdk.Stack, environment string) {
+	awsecr.NewCfnPullThroughCacheRule(stack, jsii.String("PublicImageCacheRule"), &awsecr.CfnPullThroughCacheRuleProps{
+		EcrRepositoryPrefix: jsii.String(config.PullThroughCachePrefix(environment)),
+		UpstreamRegistryUrl: jsii.String(config.PublicECRRegistry),
+	})
+}
@@ -5,13 +5,25 @@ package stacks
 
 import (
 	"aws-ecs-fargate-go-cdk/internal/config"
+	networkConstruct "aws-ecs-fargate-go-cdk/internal/constructs"
+	"crypto/sha256"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/aws/aws-cdk-go/awscdk/v2"
 	"github.com/aws/aws-cdk-go/awscdk/v2/awsec2"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awsecs"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awsefs"
 	"github.com/aws/aws-cdk-go/awscdk/v2/awselasticache"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awsiam"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awskms"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awslambda"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awslogs"
 	"github.com/aws/aws-cdk-go/awscdk/v2/awsrds"
 	"github.com/aws/aws-cdk-go/awscdk/v2/awss3"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awssecretsmanager"
+	"github.com/aws/aws-cdk-go/awscdk/v2/regioninfo"
 	"github.com/aws/constructs-go/constructs/v10"
 	"github.com/aws/jsii-runtime-go"
 )
@@ -24,6 +36,48 @@ type StorageStackProps struct {
 	PrivateSubnetIds      []string // プライベートサブネットID
 	DatabaseSecurityGroup string   // データベース用セキュリティグループ
 	TestEnvFlag           bool     // テスト環境フラグ
+
+	// Auroraのエンジン・容量設定（未指定の場合は環境設定を使用）
+	DatabaseEngine        string // aurora-mysql, aurora-postgresql（ApplicationStackと同じ設定にすること）
+	DatabaseEngineVersion string
+	DatabaseCapacity      *config.DatabaseCapacityConfig
+	DatabaseProxy         *config.DatabaseProxyConfig
+	DatabaseParameters    *config.DatabaseParametersConfig
+	DatabaseMonitoring    *config.DatabaseMonitoringConfig
+	DatabaseGlobal        *config.DatabaseGlobalConfig
+	DatabaseSeed          *config.DatabaseSeedConfig
+
+	// ElastiCache設定（未指定の場合は環境設定を使用）
+	CacheEngine        string // redis, valkey
+	CacheEngineVersion string // 未指定の場合はエンジンの既定バージョン
+	CacheNodeType      string
+	CacheDataTiering   *bool
+	CacheTopology      *config.CacheTopologyConfig
+	CacheParameters    map[string]string
+	CacheAuth          *config.CacheAuthConfig
+
+	// S3バケット設定（未指定の場合は環境設定を使用）
+	Buckets *config.StorageBucketsConfig
+
+	// 静的アセット配信用CloudFront設定（未指定の場合は環境設定を使用）
+	CDN *config.CDNConfig
+
+	// データ分類別のKMSキー設定（未指定の場合は環境設定を使用、KeyStackと同じ設定にすること）
+	Encryption *config.EncryptionConfig
+
+	// タスク間で共有するEFS設定（未指定の場合は環境設定を使用）
+	FileSystem *config.FileSystemConfig
+
+	// trueの場合、環境設定に関わらずEgressを制限（NetworkStackと同じ設定にすること）
+	RestrictEgress bool
+
+	// セキュリティグループ定義（未指定の場合は環境設定を使用、NetworkStackと同じ設定にすること）
+	// 参照するティアは定義から取得し、Egress制限は定義のrestrictEgressを使用
+	SecurityMatrix *config.SecurityMatrixConfig
+
+	// Global Databaseでの役割（未指定の場合はprimary）
+	// secondaryの場合はDRリージョンのセカンダリクラスターのみを作成
+	DatabaseRole string
 }
 
 // VPCReferenceProps インターフェースの実装
@@ -43,21 +97,40 @@ func (p *StorageStackProps) IsTestEnvironment() bool {
 type StorageStackOutputs struct {
 	AuroraClusterEndpoint  string
 	AuroraReaderEndpoint   string
+	DatabaseSecretArn      string
+	DatabaseProxyEndpoint  string // RDS Proxy無効時は空
 	ElastiCacheEndpoint    string
+	RedisSecretArn         string
 	StaticAssetsBucketName string
 	LogsBucketName         string
 	BackupsBucketName      string
+	StaticAssetsUrl        string // CloudFront無効時は空
+	FileSystemId           string // EFS無効時は空
+}
+
+// IAuroraCluster 新規作成・スナップショットからの復元のいずれにも対応するAuroraクラスター
+type IAuroraCluster interface {
+	awsrds.DatabaseClusterBase
+	AddRotationSingleUser(options *awsrds.RotationSingleUserOptions) awssecretsmanager.SecretRotation
+	AddRotationMultiUser(id *string, options *awsrds.RotationMultiUserOptions) awssecretsmanager.SecretRotation
 }
 
 // StorageStack StorageStackの構造体
 type StorageStack struct {
 	awscdk.Stack
-	AuroraCluster awsrds.DatabaseCluster
-	ElastiCache   awselasticache.CfnReplicationGroup
-	StaticBucket  awss3.Bucket
-	LogsBucket    awss3.Bucket
-	BackupsBucket awss3.Bucket
-	Outputs       *StorageStackOutputs
+	AuroraCluster  IAuroraCluster
+	DatabaseSecret awssecretsmanager.ISecret // アプリケーションユーザーの認証情報
+	DatabaseProxy  awsrds.DatabaseProxy      // RDS Proxy無効時はnil
+	ElastiCache    *networkConstruct.CacheCluster
+	CacheSecret    awssecretsmanager.ISecret // アプリケーションが使用するRedis認証情報
+	StaticBucket   awss3.Bucket
+	LogsBucket     awss3.Bucket
+	BackupsBucket  awss3.Bucket
+	CDN            *networkConstruct.StaticAssetsCDN // CloudFront無効時はnil
+	FileSystem     awsefs.FileSystem                 // EFS無効時はnil
+	AccessPoints   map[string]awsefs.AccessPoint     // アクセスポイント名をキーにしたアクセスポイント
+	DataKeys       *networkConstruct.DataKeys        // KeyStackのデータ分類別キー
+	Outputs        *StorageStackOutputs
 }
 
 // NewStorageStack StorageStackを作成
@@ -78,34 +151,203 @@ func NewStorageStack(scope constructs.Construct, id string, props *StorageStackP
 	if err != nil {
 		panic("Invalid environment: " + props.Environment)
 	}
+	if props.RestrictEgress {
+		envConfig.RestrictEgress = true
+	}
+
+	// セキュリティグループ定義（定義を指定した場合はEgress制限も定義に従う）
+	securityMatrix := resolveSecurityMatrix(props.Environment, props.SecurityMatrix, envConfig)
 
 	// VPCの参照を取得（テスト環境対応）
 	vpc := getVPCReferenceForStorage(stack, props)
 
+	// エンジン設定（ポートはセキュリティグループの許可と共通）
+	dbConfig := config.GetDatabaseConfig(props.Environment)
+	cacheConfig := config.GetCacheConfig(props.Environment)
+
+	// Auroraエンジン・容量設定（プロパティで指定されていない場合は環境設定を使用）
+	if props.DatabaseEngine != "" {
+		dbConfig.SetEngine(props.DatabaseEngine, props.DatabaseEngineVersion)
+		dbConfig.Parameters = config.GetDatabaseParametersConfig(props.Environment, dbConfig.Engine, dbConfig.EngineVersion)
+	}
+	if props.DatabaseCapacity != nil {
+		dbConfig.Capacity = *props.DatabaseCapacity
+	}
+	if props.DatabaseProxy != nil {
+		dbConfig.Proxy = *props.DatabaseProxy
+	}
+	if props.DatabaseParameters != nil {
+		dbConfig.Parameters = *props.DatabaseParameters
+	}
+	if props.DatabaseMonitoring != nil {
+		dbConfig.Monitoring = *props.DatabaseMonitoring
+	}
+	if props.DatabaseGlobal != nil {
+		dbConfig.Global = *props.DatabaseGlobal
+	}
+	if props.DatabaseSeed != nil {
+		dbConfig.Seed = *props.DatabaseSeed
+	}
+	if props.CacheEngine != "" {
+		cacheConfig.SetEngine(props.CacheEngine, props.CacheEngineVersion)
+	}
+	if props.CacheNodeType != "" {
+		cacheConfig.NodeType = props.CacheNodeType
+	}
+	if props.CacheDataTiering != nil {
+		cacheConfig.DataTiering = *props.CacheDataTiering
+	}
+	if props.CacheTopology != nil {
+		cacheConfig.Topology = *props.CacheTopology
+	}
+	if props.CacheParameters != nil {
+		cacheConfig.Parameters = props.CacheParameters
+	}
+	if props.CacheAuth != nil {
+		cacheConfig.Auth = *props.CacheAuth
+	}
+	if err := config.ValidateDatabaseConfig(dbConfig); err != nil {
+		panic("Invalid database configuration: " + err.Error())
+	}
+	if err := config.ValidateCacheConfig(cacheConfig); err != nil {
+		panic("Invalid cache configuration: " + err.Error())
+	}
+
+	// S3バケット設定（プロパティで指定されていない場合は環境設定を使用）
+	bucketsConfig := config.GetStorageBucketsConfig(props.Environment)
+	if props.Buckets != nil {
+		bucketsConfig = *props.Buckets
+	}
+	if err := config.ValidateStorageBucketsConfig(bucketsConfig); err != nil {
+		panic("Invalid bucket configuration: " + err.Error())
+	}
+
+	// CloudFront設定（プロパティで指定されていない場合は環境設定を使用）
+	cdnConfig := config.GetCDNConfig(props.Environment)
+	if props.CDN != nil {
+		cdnConfig = *props.CDN
+	}
+	if err := config.ValidateCDNConfig(cdnConfig); err != nil {
+		panic("Invalid CDN configuration: " + err.Error())
+	}
+
+	// EFS設定（プロパティで指定されていない場合は環境設定を使用）
+	fileSystemConfig := config.GetFileSystemConfig(props.Environment)
+	if props.FileSystem != nil {
+		fileSystemConfig = *props.FileSystem
+	}
+	if err := config.ValidateFileSystemConfig(fileSystemConfig); err != nil {
+		panic("Invalid file system configuration: " + err.Error())
+	}
+	validateDatabaseRole(stack, props.DatabaseRole, dbConfig)
+
+	// KeyStackのデータ分類別キー（CMKを使用しない分類はAWS管理キー）
+	dataKeys := getStorageDataKeys(stack, props)
+
+	// DRリージョンのセカンダリクラスター（データベースのみを作成）
+	if props.DatabaseRole == config.DatabaseRoleSecondary {
+		createSecondaryDatabaseResources(stack, props, envConfig, securityMatrix, dbConfig, vpc, dataKeys)
+		addStorageStackTags(stack, envConfig)
+		return stack
+	}
+
+	// データベース・キャッシュで個別のセキュリティグループを参照し、ECSタスクからの通信をエンジンのポートで許可
+	// （RDS Proxy有効時もマイグレーション（マスターユーザー）はECSタスクからクラスターに直接接続する）
+	securityGroups := getStorageSecurityGroups(stack, props, envConfig, securityMatrix)
+	securityGroups.AllowAppToDatabase(dbConfig.Port, dbConfig.ProtocolName())
+	securityGroups.AllowAppToCache(cacheConfig.Port, cacheConfig.EngineName())
+	dbSecurityGroup := securityGroups.SecurityGroup("RDS")
+	cacheSecurityGroup := securityGroups.SecurityGroup("Cache")
+
 	// データベースサブネットグループ作成
 	dbSubnetGroup := createDatabaseSubnetGroup(stack, envConfig, vpc, props.TestEnvFlag)
 
-	// Aurora MySQL Cluster作成
-	auroraCluster := createAuroraCluster(stack, envConfig, vpc, dbSubnetGroup)
+	// 認証情報ローテーション用Lambdaのセキュリティグループ（Egress制限時のみ、それ以外はCDKが作成）
+	rotationSecurityGroup := createRotationSecurityGroup(stack, envConfig, vpc, securityGroups)
+
+	// Aurora Cluster作成
+	auroraCluster, adminSecret := createAuroraCluster(stack, envConfig, dbConfig, vpc, dbSubnetGroup, dbSecurityGroup, rotationSecurityGroup, dataKeys)
+
+	// Global Database（このクラスターをプライマリとして登録）
+	if dbConfig.Global.Enabled {
+		createAuroraGlobalCluster(stack, envConfig, dbConfig, auroraCluster)
+	}
+
+	// アプリケーションユーザーの認証情報（ECSタスクに共有する唯一のDB認証情報）
+	databaseSecret := createDatabaseAppSecret(stack, envConfig, dbConfig, auroraCluster, adminSecret, rotationSecurityGroup, dataKeys.Key(config.DataClassSecrets))
+
+	// アプリケーションユーザーの作成・権限付与（デプロイ時にマスターユーザーで実行）
+	appUserTask := createDatabaseAppUser(stack, props.Environment, envConfig, dbConfig, vpc, auroraCluster, adminSecret, databaseSecret, securityGroups)
+
+	// 復元・クローンしたデータの個人情報マスキング
+	// マスキングの成功後にアプリケーションユーザーのパスワードを設定し、失敗した場合はデプロイを失敗させる
+	if dbConfig.Seed.Mode != config.DatabaseSeedNone && dbConfig.Seed.Masking.Enabled {
+		maskingTask := createDataMaskingTask(stack, props.Environment, envConfig, dbConfig, vpc, auroraCluster, adminSecret, databaseSecret, securityGroups)
+		appUserTask.Node().AddDependency(maskingTask.Resource)
+	}
+
+	// RDS Proxy作成（有効な場合のみ、ECSタスクはProxy経由で接続）
+	var databaseProxy awsrds.DatabaseProxy
+	if dbConfig.Proxy.Enabled {
+		databaseProxy = createDatabaseProxy(stack, envConfig, dbConfig, vpc, auroraCluster, databaseSecret)
+		securityGroups.AllowAppToDatabaseProxy(databaseProxy, dbConfig.Port)
+
+		// Egress制限時はProxyが認証情報を取得するSecrets ManagerへVPCエンドポイント経由で接続
+		if envConfig.RestrictEgress {
+			securityGroups.AllowFrom(databaseProxy, "Endpoints", awsec2.Port_Tcp(jsii.Number(443)), "Allow HTTPS from RDS Proxy to VPC endpoints")
+		}
+	}
 
 	// ElastiCache Redis作成
-	elastiCache := createElastiCacheCluster(stack, envConfig, props.TestEnvFlag)
+	elastiCache, cacheSecret := createElastiCacheCluster(stack, envConfig, cacheConfig, vpc, cacheSecurityGroup, dataKeys)
+
+	// CMKへの移行で新しい物理名のリソースを作成する場合は移行手順を出力
+	if dataKeys.Config.ReplacesResources() {
+		createEncryptionMigrationRunbookOutput(stack, props.Environment, envConfig, dataKeys.Config)
+	}
 
 	// S3 Buckets作成
-	staticBucket, logsBucket, backupsBucket := createS3Buckets(stack, envConfig)
+	staticBucket, logsBucket, backupsBucket := createS3Buckets(stack, envConfig, bucketsConfig, dataKeys)
+
+	// 静的アセット配信用CloudFront（有効な場合のみ）
+	var cdn *networkConstruct.StaticAssetsCDN
+	if cdnConfig.Enabled {
+		cdn = createStaticAssetsCDN(stack, envConfig, &cdnConfig, staticBucket)
+	}
+
+	// タスク間で共有するEFS（有効な場合のみ、ECSタスクからのNFSを許可）
+	var fileSystem awsefs.FileSystem
+	var accessPoints map[string]awsefs.AccessPoint
+	if fileSystemConfig.Enabled {
+		fileSystem, accessPoints = createFileSystem(stack, envConfig, &fileSystemConfig, vpc, dataKeys.Key(config.DataClassFiles))
+		securityGroups.AllowAppToFileSystem(fileSystem, config.FileSystemPort)
+	}
 
 	// Cross-stack出力作成
-	outputs := createStorageStackOutputs(stack, auroraCluster, elastiCache, staticBucket, logsBucket, backupsBucket, envConfig.Name)
+	outputs := createStorageStackOutputs(stack, dbConfig, auroraCluster, databaseSecret, databaseProxy, elastiCache, cacheSecret, staticBucket, logsBucket, backupsBucket, envConfig.Name)
+	if cdn != nil {
+		outputs.StaticAssetsUrl = *cdn.URL()
+	}
+	if fileSystem != nil {
+		outputs.FileSystemId = *fileSystem.FileSystemId()
+	}
 
 	// StorageStackインスタンスにリソースを設定
 	storageStack := &StorageStack{
-		Stack:         stack,
-		AuroraCluster: auroraCluster,
-		ElastiCache:   elastiCache,
-		StaticBucket:  staticBucket,
-		LogsBucket:    logsBucket,
-		BackupsBucket: backupsBucket,
-		Outputs:       outputs,
+		Stack:          stack,
+		AuroraCluster:  auroraCluster,
+		DatabaseSecret: databaseSecret,
+		DatabaseProxy:  databaseProxy,
+		ElastiCache:    elastiCache,
+		CacheSecret:    cacheSecret,
+		StaticBucket:   staticBucket,
+		LogsBucket:     logsBucket,
+		BackupsBucket:  backupsBucket,
+		CDN:            cdn,
+		FileSystem:     fileSystem,
+		AccessPoints:   accessPoints,
+		DataKeys:       dataKeys,
+		Outputs:        outputs,
 	}
 
 	// デバッグ
@@ -121,6 +363,32 @@ func getVPCReferenceForStorage(stack awscdk.Stack, props *StorageStackProps) aws
 	return GetVPCReference(stack, props)
 }
 
+// getStorageSecurityGroups NetworkStackのティア別セキュリティグループを参照（テスト環境対応）
+func getStorageSecurityGroups(stack awscdk.Stack, props *StorageStackProps, envConfig *config.EnvironmentConfig, securityMatrix *config.SecurityMatrixConfig) *networkConstruct.ServiceSecurityGroups {
+	// セキュリティグループ定義の全ティアを参照（定義ファイルで追加したティアを含む）
+	tiers := securityMatrix.TierNames()
+
+	// テスト環境では固定のセキュリティグループID、実環境ではCross-stack参照
+	securityGroupIds := map[string]string{}
+	if props.TestEnvFlag {
+		for _, tier := range tiers {
+			securityGroupIds[tier] = "sg-test-" + strings.ToLower(tier) + "-" + props.Environment
+		}
+	}
+
+	return networkConstruct.ImportServiceSecurityGroups(stack, "ImportedSecurityGroups", &networkConstruct.ServiceSecurityGroupsImportProps{
+		Environment:      props.Environment,
+		Tiers:            tiers,
+		SecurityGroupIds: securityGroupIds,
+		AllowAllOutbound: !envConfig.RestrictEgress, // Egress制限時はECS側のEgressも作成
+	})
+}
+
+// getStorageDataKeys KeyStackのデータ分類別キーを参照（テスト環境対応）
+func getStorageDataKeys(stack awscdk.Stack, props *StorageStackProps) *networkConstruct.DataKeys {
+	return importDataKeys(stack, props.Environment, props.Encryption, props.TestEnvFlag)
+}
+
 // createDatabaseSubnetGroup データベースサブネットグループを作成（テスト環境対応）
 func createDatabaseSubnetGroup(stack awscdk.Stack, envConfig *config.EnvironmentConfig, vpc awsec2.IVpc, isTestEnvironment bool) awsrds.SubnetGroup {
 	return awsrds.NewSubnetGroup(stack, jsii.String("DatabaseSubnetGroup"), &awsrds.SubnetGroupProps{
@@ -141,44 +409,60 @@ func createDatabaseSubnetGroup(stack awscdk.Stack, envConfig *config.Environment
 	})
 }
 
-// createAuroraCluster Aurora MySQL Clusterを作成（既存コードと同じ）
-func createAuroraCluster(stack awscdk.Stack, envConfig *config.EnvironmentConfig, vpc awsec2.IVpc, subnetGroup awsrds.SubnetGroup) awsrds.DatabaseCluster {
-	// 環境別インスタンス設定
-	instanceCount := getAuroraInstanceCount(envConfig.Name)
-	instanceType := getAuroraInstanceType(envConfig.Name)
-
-	// Aurora Cluster作成
-	// Aurora Engine設定（正確なバージョン指定）
-	engine := awsrds.DatabaseClusterEngine_AuroraMysql(&awsrds.AuroraMysqlClusterEngineProps{
-		Version: awsrds.AuroraMysqlEngineVersion_VER_5_7_12(), // より新しい安定版を使用
+// createAuroraCluster Aurora Clusterを作成（エンジンは設定から選択）
+func createAuroraCluster(
+	stack awscdk.Stack,
+	envConfig *config.EnvironmentConfig,
+	dbConfig *config.DatabaseConfig,
+	vpc awsec2.IVpc,
+	subnetGroup awsrds.SubnetGroup,
+	securityGroup awsec2.ISecurityGroup,
+	rotationSecurityGroup awsec2.ISecurityGroup,
+	dataKeys *networkConstruct.DataKeys,
+) (IAuroraCluster, awsrds.DatabaseSecret) {
+	capacity := dbConfig.Capacity
+	monitoring := dbConfig.Monitoring
+
+	// マスターユーザーの認証情報（StorageStackで管理）
+	adminSecret := awsrds.NewDatabaseSecret(stack, jsii.String("AuroraAdminSecret"), &awsrds.DatabaseSecretProps{
+		Username:          jsii.String(dbConfig.Credentials.AdminUsername),
+		SecretName:        jsii.String("service-" + envConfig.Name + "-db-admin"),
+		ExcludeCharacters: jsii.String(`"@/\`),
+		EncryptionKey:     dataKeys.Key(config.DataClassSecrets),
 	})
 
-	// Aurora Cluster作成（新しいwriter/readers APIを使用）
-	cluster := awsrds.NewDatabaseCluster(stack, jsii.String("AuroraCluster"), &awsrds.DatabaseClusterProps{
-		Engine: engine,
+	// Aurora Engine設定（エンジンファミリー・バージョンは設定から取得）
+	engine := createAuroraEngine(dbConfig)
 
-		// 新しいwriter/readers API使用
-		Writer: awsrds.ClusterInstance_Provisioned(jsii.String("writer"), &awsrds.ProvisionedClusterInstanceProps{
-			InstanceType: instanceType,
-		}),
+	// エンジンファミリーに対応したクラスター・インスタンスパラメータグループ
+	parameterGroup := awsrds.NewParameterGroup(stack, jsii.String("AuroraClusterParameterGroup"), &awsrds.ParameterGroupProps{
+		Engine:      engine,
+		Description: jsii.String("Cluster parameter group for service-" + envConfig.Name + " (" + dbConfig.Engine + ")"),
+		Parameters:  toStringPtrMap(dbConfig.ClusterParameters()),
+	})
+	instanceParameterGroup := createAuroraInstanceParameterGroup(stack, envConfig, dbConfig, engine)
 
-		Readers: func() *[]awsrds.IClusterInstance {
-			if instanceCount <= 1 {
-				// 単一インスタンスの場合はReadersなし
-				return &[]awsrds.IClusterInstance{}
+	// Aurora Cluster作成（新しいwriter/readers APIを使用）
+	clusterProps := &awsrds.DatabaseClusterProps{
+		Engine:         engine,
+		ParameterGroup: parameterGroup,
+
+		// 容量モードに応じたwriter/readers
+		Writer:  createAuroraWriter(capacity, instanceParameterGroup),
+		Readers: createAuroraReaders(capacity, instanceParameterGroup),
+
+		// Serverless v2の容量範囲（Serverless v2インスタンスを含む場合のみ）
+		ServerlessV2MinCapacity: func() *float64 {
+			if capacity.UsesServerlessV2() {
+				return jsii.Number(capacity.MinACU)
 			}
-
-			// 複数インスタンスの場合、Reader作成
-			readers := make([]awsrds.IClusterInstance, (int)(instanceCount-1))
-			for i := 0; i < int(instanceCount-1); i++ {
-				readers[i] = awsrds.ClusterInstance_Provisioned(
-					jsii.String(fmt.Sprintf("reader%d", i+1)),
-					&awsrds.ProvisionedClusterInstanceProps{
-						InstanceType: instanceType,
-					},
-				)
+			return nil
+		}(),
+		ServerlessV2MaxCapacity: func() *float64 {
+			if capacity.UsesServerlessV2() {
+				return jsii.Number(capacity.MaxACU)
 			}
-			return &readers
+			return nil
 		}(),
 
 		// VPC設定
@@ -188,10 +472,15 @@ func createAuroraCluster(stack awscdk.Stack, envConfig *config.EnvironmentConfig
 		},
 
 		SubnetGroup:         subnetGroup,
-		DefaultDatabaseName: jsii.String("service"),
+		Credentials:         awsrds.Credentials_FromSecret(adminSecret, nil),
+		DefaultDatabaseName: jsii.String(config.DatabaseName),
+
+		// データベース専用のセキュリティグループ・ポート
+		SecurityGroups: &[]awsec2.ISecurityGroup{securityGroup},
+		Port:           jsii.Number(dbConfig.Port),
 
-		// クラスター識別子
-		ClusterIdentifier: jsii.String("service-" + envConfig.Name + "-aurora-cluster"),
+		// クラスター識別子（CMKへの移行時は新しい識別子）
+		ClusterIdentifier: jsii.String(dataKeys.Config.ResourceName(config.DataClassDatabase, primaryClusterIdentifier(envConfig))),
 
 		// バックアップ設定（環境別）
 		Backup: &awsrds.BackupProps{
@@ -214,24 +503,43 @@ func createAuroraCluster(stack awscdk.Stack, envConfig *config.EnvironmentConfig
 		PreferredMaintenanceWindow: jsii.String("sun:04:00-sun:05:00"), // JST日曜13:00-14:00
 
 		// セキュリティ設定
-		StorageEncrypted:   jsii.Bool(true),
-		DeletionProtection: jsii.Bool(envConfig.Name == "production"),
-
-		// ログ設定
-		CloudwatchLogsExports: &[]*string{
-			jsii.String("error"),
-			jsii.String("general"),
-			jsii.String("slowquery"),
-		},
+		StorageEncrypted:     jsii.Bool(true),
+		StorageEncryptionKey: dataKeys.Key(config.DataClassDatabase),
+		DeletionProtection:   jsii.Bool(envConfig.Name == "production"),
+
+		// ログ設定（エンジン別、監査ログを含む）
+		CloudwatchLogsExports:   jsii.Strings(dbConfig.LogExports()...),
+		CloudwatchLogsRetention: toLogRetentionDays(monitoring.LogRetentionDays),
 
-		// 監視設定
+		// 監視設定（拡張モニタリング・Performance Insights）
 		MonitoringInterval: func() awscdk.Duration {
-			if envConfig.Name == "production" {
-				return awscdk.Duration_Seconds(jsii.Number(30)) // 1分間隔
+			if monitoring.MonitoringIntervalSeconds == 0 {
+				return nil
 			}
-			return awscdk.Duration_Seconds(jsii.Number(60)) // 5分間隔
+			return awscdk.Duration_Seconds(jsii.Number(monitoring.MonitoringIntervalSeconds))
 		}(),
-	})
+		MonitoringRole:                  createAuroraMonitoringRole(stack, envConfig, monitoring),
+		EnablePerformanceInsights:       jsii.Bool(monitoring.PerformanceInsights),
+		PerformanceInsightRetention:     toPerformanceInsightRetention(monitoring),
+		PerformanceInsightEncryptionKey: createPerformanceInsightsKey(stack, envConfig, monitoring, dataKeys.Key(config.DataClassDatabase)),
+	}
+
+	// 初期データの設定に応じて新規作成・スナップショットから復元・クローン
+	// CMKへの移行時は初期データの指定がなければ移行前のスナップショットから復元
+	seed := dbConfig.Seed
+	if seed.Mode == config.DatabaseSeedNone && dataKeys.Config.RestoresFromSnapshot(config.DataClassDatabase) {
+		seed = config.DatabaseSeedConfig{
+			Mode:               config.DatabaseSeedSnapshot,
+			SnapshotIdentifier: dataKeys.Config.MigrationSnapshotName(primaryClusterIdentifier(envConfig)),
+		}
+	}
+	cluster := newAuroraCluster(stack, clusterProps, seed, adminSecret)
+
+	// メジャーバージョンのインプレースアップグレード（設定で明示的に許可した場合のみ）
+	if dbConfig.AllowMajorVersionUpgrade {
+		cluster.Node().DefaultChild().(awsrds.CfnDBCluster).AddPropertyOverride(jsii.String("AllowMajorVersionUpgrade"), jsii.Bool(true))
+		createMajorVersionUpgradeRunbookOutput(stack, envConfig, dbConfig, dataKeys.Config)
+	}
 
 	// タグ追加
 	for key, value := range envConfig.Tags {
@@ -239,154 +547,1046 @@ func createAuroraCluster(stack awscdk.Stack, envConfig *config.EnvironmentConfig
 	}
 	awscdk.Tags_Of(cluster).Add(jsii.String("Component"), jsii.String("Database"), nil)
 
-	return cluster
+	// マスターユーザーの自動ローテーション
+	if dbConfig.Credentials.RotationDays > 0 {
+		cluster.AddRotationSingleUser(&awsrds.RotationSingleUserOptions{
+			AutomaticallyAfter: awscdk.Duration_Days(jsii.Number(dbConfig.Credentials.RotationDays)),
+			SecurityGroup:      rotationSecurityGroup,
+		})
+	}
+
+	return cluster, adminSecret
 }
 
-// createElastiCacheCluster ElastiCache Redisクラスターを作成（テスト環境対応）
-func createElastiCacheCluster(stack awscdk.Stack, envConfig *config.EnvironmentConfig, isTestEnvironment bool) awselasticache.CfnReplicationGroup {
-	// Redis サブネットグループ作成
-	subnetGroup := awselasticache.NewCfnSubnetGroup(stack, jsii.String("RedisSubnetGroup"), &awselasticache.CfnSubnetGroupProps{
-		Description: jsii.String("Subnet group for Redis cluster"),
-		SubnetIds: func() *[]*string {
-			if isTestEnvironment {
-				// テスト環境では固定のサブネットID
-				return &[]*string{
-					jsii.String("subnet-test-private-1"),
-					jsii.String("subnet-test-private-2"),
-				}
+// newAuroraCluster 初期データの設定に応じてAurora Clusterを作成
+func newAuroraCluster(stack awscdk.Stack, props *awsrds.DatabaseClusterProps, seed config.DatabaseSeedConfig, adminSecret awsrds.DatabaseSecret) IAuroraCluster {
+	id := jsii.String("AuroraCluster")
+
+	switch seed.Mode {
+	case config.DatabaseSeedSnapshot:
+		// マスターユーザーのパスワードは復元後にStorageStackのシークレットの値に変更
+		// （データベース名はスナップショットから引き継ぐ）
+		return awsrds.NewDatabaseClusterFromSnapshot(stack, id, &awsrds.DatabaseClusterFromSnapshotProps{
+			SnapshotIdentifier:  jsii.String(seed.SnapshotIdentifier),
+			SnapshotCredentials: awsrds.SnapshotCredentials_FromSecret(adminSecret),
+
+			Engine:                          props.Engine,
+			ParameterGroup:                  props.ParameterGroup,
+			Writer:                          props.Writer,
+			Readers:                         props.Readers,
+			ServerlessV2MinCapacity:         props.ServerlessV2MinCapacity,
+			ServerlessV2MaxCapacity:         props.ServerlessV2MaxCapacity,
+			Vpc:                             props.Vpc,
+			VpcSubnets:                      props.VpcSubnets,
+			SubnetGroup:                     props.SubnetGroup,
+			SecurityGroups:                  props.SecurityGroups,
+			Port:                            props.Port,
+			ClusterIdentifier:               props.ClusterIdentifier,
+			Backup:                          props.Backup,
+			PreferredMaintenanceWindow:      props.PreferredMaintenanceWindow,
+			StorageEncrypted:                props.StorageEncrypted,
+			StorageEncryptionKey:            props.StorageEncryptionKey,
+			DeletionProtection:              props.DeletionProtection,
+			CloudwatchLogsExports:           props.CloudwatchLogsExports,
+			CloudwatchLogsRetention:         props.CloudwatchLogsRetention,
+			MonitoringInterval:              props.MonitoringInterval,
+			MonitoringRole:                  props.MonitoringRole,
+			EnablePerformanceInsights:       props.EnablePerformanceInsights,
+			PerformanceInsightRetention:     props.PerformanceInsightRetention,
+			PerformanceInsightEncryptionKey: props.PerformanceInsightEncryptionKey,
+		})
+	case config.DatabaseSeedClone:
+		cluster := awsrds.NewDatabaseCluster(stack, id, props)
+
+		// コピーオンライトクローン（L2未対応のためCloudFormationのプロパティを上書き）
+		// マスターユーザー名・データベース名はクローン元から引き継ぎ、パスワードはシークレットの値に変更
+		cfnCluster := cluster.Node().DefaultChild().(awsrds.CfnDBCluster)
+		cfnCluster.AddPropertyOverride(jsii.String("RestoreType"), jsii.String("copy-on-write"))
+		cfnCluster.AddPropertyOverride(jsii.String("SourceDBClusterIdentifier"), jsii.String(seed.SourceClusterIdentifier))
+		cfnCluster.AddPropertyOverride(jsii.String("UseLatestRestorableTime"), jsii.Bool(true))
+		cfnCluster.AddPropertyDeletionOverride(jsii.String("MasterUsername"))
+		cfnCluster.AddPropertyDeletionOverride(jsii.String("DatabaseName"))
+		return cluster
+	default:
+		return awsrds.NewDatabaseCluster(stack, id, props)
+	}
+}
+
+// createDataMaskingTask 復元・クローンしたクラスターの個人情報をマスキングするECSタスクを作成
+// クラスターの作成・置換時に一度だけ実行し、タスクが終了コード0で停止しない場合はデプロイを失敗させる
+func createDataMaskingTask(
+	stack awscdk.Stack,
+	environment string,
+	envConfig *config.EnvironmentConfig,
+	dbConfig *config.DatabaseConfig,
+	vpc awsec2.IVpc,
+	cluster IAuroraCluster,
+	adminSecret awssecretsmanager.ISecret,
+	appSecret awssecretsmanager.ISecret,
+	securityGroups *networkConstruct.ServiceSecurityGroups,
+) *networkConstruct.OneShotTask {
+	masking := dbConfig.Seed.Masking
+	sgName := "Service-" + envConfig.Name + "-DataMasking-SG"
+
+	// マスキングタスク専用のセキュリティグループ（マスターユーザーでクラスターに直接接続）
+	securityGroup := awsec2.NewSecurityGroup(stack, jsii.String("DataMaskingSecurityGroup"), &awsec2.SecurityGroupProps{
+		Vpc:               vpc,
+		Description:       jsii.String("Security group for data masking task"),
+		SecurityGroupName: jsii.String(sgName),
+		AllowAllOutbound:  jsii.Bool(!envConfig.RestrictEgress),
+	})
+	awscdk.Tags_Of(securityGroup).Add(jsii.String("Name"), jsii.String(sgName), nil)
+	securityGroups.AllowFrom(securityGroup, "RDS", awsec2.Port_Tcp(jsii.Number(dbConfig.Port)), "Allow database traffic from data masking task")
+
+	ecsCluster := awsecs.NewCluster(stack, jsii.String("DataMaskingCluster"), &awsecs.ClusterProps{
+		Vpc:         vpc,
+		ClusterName: jsii.String("service-" + envConfig.Name + "-data-masking"),
+	})
+
+	taskDefinition := awsecs.NewFargateTaskDefinition(stack, jsii.String("DataMaskingTaskDefinition"), &awsecs.FargateTaskDefinitionProps{
+		Family:         jsii.String("service-" + envConfig.Name + "-data-masking"),
+		Cpu:            jsii.Number(masking.CPU),
+		MemoryLimitMiB: jsii.Number(masking.Memory),
+	})
+
+	taskDefinition.AddContainer(jsii.String("data-masking"), &awsecs.ContainerDefinitionOptions{
+		Image: publicContainerImage(stack, "DataMaskingImageRepository", taskDefinition, environment, masking.Image, envConfig.RestrictEgress),
+		Command: func() *[]*string {
+			if len(masking.Command) == 0 {
+				return nil
 			}
-			// 実環境ではCross-stack参照
-			subnetIds := awscdk.Fn_Split(jsii.String(","),
-				awscdk.Fn_ImportValue(jsii.String("service-"+envConfig.Name+"-PrivateSubnetIds")), nil)
-			return subnetIds
+			return jsii.Strings(masking.Command...)
 		}(),
-		CacheSubnetGroupName: jsii.String("service-" + envConfig.Name + "-redis-subnet-group"),
+		Environment: &map[string]*string{
+			"DB_CONNECTION": jsii.String(dbConfig.ConnectionName()),
+			"DB_HOST":       cluster.ClusterEndpoint().Hostname(),
+			"DB_PORT":       jsii.String(strconv.Itoa(dbConfig.Port)),
+		},
+		Secrets: &map[string]awsecs.Secret{
+			"DB_ADMIN_USERNAME": awsecs.Secret_FromSecretsManager(adminSecret, jsii.String("username")),
+			"DB_ADMIN_PASSWORD": awsecs.Secret_FromSecretsManager(adminSecret, jsii.String("password")),
+			"DB_USERNAME":       awsecs.Secret_FromSecretsManager(appSecret, jsii.String("username")),
+			"DB_PASSWORD":       awsecs.Secret_FromSecretsManager(appSecret, jsii.String("password")),
+		},
+		Logging: awsecs.LogDriver_AwsLogs(&awsecs.AwsLogDriverProps{
+			StreamPrefix: jsii.String("data-masking"),
+			LogRetention: toLogRetentionDays(dbConfig.Monitoring.LogRetentionDays),
+		}),
 	})
 
-	// 環境別Redis設定
-	nodeType, numNodes := getRedisConfiguration(envConfig.Name)
+	// クラスターの作成・置換時に一度だけタスクを起動し、停止するまで待機（インスタンスの作成完了後）
+	// イメージ・シークレットの取得とログ出力はECSティアの経路を使用（Egress制限時はVPCエンドポイント経由）
+	timeout := masking.TimeoutMinutes
+	if timeout == 0 {
+		timeout = 60
+	}
+	task := networkConstruct.NewOneShotTask(stack, "RunDataMaskingTask", &networkConstruct.OneShotTaskProps{
+		Cluster:        ecsCluster,
+		TaskDefinition: taskDefinition,
+		VpcSubnets: &awsec2.SubnetSelection{
+			SubnetType: awsec2.SubnetType_PRIVATE_WITH_EGRESS,
+		},
+		SecurityGroups: []awsec2.ISecurityGroup{securityGroup, securityGroups.SecurityGroup("ECS")},
+		Trigger:        cluster.ClusterResourceIdentifier(),
+		Timeout:        awscdk.Duration_Minutes(jsii.Number(timeout)),
+	})
+	task.Node().AddDependency(cluster)
 
-	// Redis Replication Group作成
-	replicationGroup := awselasticache.NewCfnReplicationGroup(stack, jsii.String("RedisCluster"), &awselasticache.CfnReplicationGroupProps{
-		ReplicationGroupDescription: jsii.String("Redis cluster for service " + envConfig.Name),
-		ReplicationGroupId:          jsii.String("service-" + envConfig.Name + "-redis"),
-		Engine:                      jsii.String("redis"),
-		CacheNodeType:               jsii.String(nodeType),
-		NumCacheClusters:            jsii.Number(numNodes),
-		CacheSubnetGroupName:        subnetGroup.CacheSubnetGroupName(),
+	awscdk.NewCfnOutput(stack, jsii.String("DataMaskingTaskDefinitionArn"), &awscdk.CfnOutputProps{
+		Value:       taskDefinition.TaskDefinitionArn(),
+		Description: jsii.String("Data masking task definition (runs automatically when the cluster is restored)"),
+	})
 
-		// セキュリティ設定
-		AtRestEncryptionEnabled:  jsii.Bool(true),
-		TransitEncryptionEnabled: jsii.Bool(true),
-
-		// セキュリティグループ
-		SecurityGroupIds: func() *[]*string {
-			if isTestEnvironment {
-				// テスト環境では固定のセキュリティグループID
-				return &[]*string{jsii.String("sg-test-12345")}
+	return task
+}
+
+// createAuroraMonitoringRole 拡張モニタリング用のIAMロールを作成（無効の場合はnil）
+func createAuroraMonitoringRole(stack awscdk.Stack, envConfig *config.EnvironmentConfig, monitoring config.DatabaseMonitoringConfig) awsiam.IRole {
+	if monitoring.MonitoringIntervalSeconds == 0 {
+		return nil
+	}
+	return awsiam.NewRole(stack, jsii.String("AuroraMonitoringRole"), &awsiam.RoleProps{
+		AssumedBy: awsiam.NewServicePrincipal(jsii.String("monitoring.rds.amazonaws.com"), nil),
+		ManagedPolicies: &[]awsiam.IManagedPolicy{
+			awsiam.ManagedPolicy_FromAwsManagedPolicyName(jsii.String("service-role/AmazonRDSEnhancedMonitoringRole")),
+		},
+		Description: jsii.String("Aurora enhanced monitoring role for " + envConfig.Name),
+	})
+}
+
+// createPerformanceInsightsKey Performance Insights用のKMSキーを作成（AWS管理キーを使用する場合はnil）
+// データベースのCMKがある場合は専用のキーを作成せずに共用
+func createPerformanceInsightsKey(stack awscdk.Stack, envConfig *config.EnvironmentConfig, monitoring config.DatabaseMonitoringConfig, databaseKey awskms.IKey) awskms.IKey {
+	if !monitoring.PerformanceInsights || !monitoring.PerformanceInsightsCustomerKey {
+		return nil
+	}
+	if databaseKey != nil {
+		return databaseKey
+	}
+	return awskms.NewKey(stack, jsii.String("PerformanceInsightsKey"), &awskms.KeyProps{
+		Alias:             jsii.String("alias/service-" + envConfig.Name + "-performance-insights"),
+		Description:       jsii.String("Performance Insights encryption key for service-" + envConfig.Name),
+		EnableKeyRotation: jsii.Bool(true),
+		RemovalPolicy:     awscdk.RemovalPolicy_RETAIN,
+	})
+}
+
+// toPerformanceInsightRetention 保持日数をPerformance Insightsの保持期間に変換（無効の場合は空）
+func toPerformanceInsightRetention(monitoring config.DatabaseMonitoringConfig) awsrds.PerformanceInsightRetention {
+	if !monitoring.PerformanceInsights {
+		return ""
+	}
+	switch monitoring.PerformanceInsightsRetentionDays {
+	case 7:
+		return awsrds.PerformanceInsightRetention_DEFAULT
+	case 731:
+		return awsrds.PerformanceInsightRetention_LONG_TERM
+	default:
+		return awsrds.PerformanceInsightRetention(fmt.Sprintf("MONTHS_%d", monitoring.PerformanceInsightsRetentionDays/31))
+	}
+}
+
+// toLogRetentionDays 保持日数をCloudWatch Logsの保持期間に変換
+func toLogRetentionDays(days int) awslogs.RetentionDays {
+	retentions := map[int]awslogs.RetentionDays{
+		1:    awslogs.RetentionDays_ONE_DAY,
+		3:    awslogs.RetentionDays_THREE_DAYS,
+		5:    awslogs.RetentionDays_FIVE_DAYS,
+		7:    awslogs.RetentionDays_ONE_WEEK,
+		14:   awslogs.RetentionDays_TWO_WEEKS,
+		30:   awslogs.RetentionDays_ONE_MONTH,
+		60:   awslogs.RetentionDays_TWO_MONTHS,
+		90:   awslogs.RetentionDays_THREE_MONTHS,
+		120:  awslogs.RetentionDays_FOUR_MONTHS,
+		150:  awslogs.RetentionDays_FIVE_MONTHS,
+		180:  awslogs.RetentionDays_SIX_MONTHS,
+		365:  awslogs.RetentionDays_ONE_YEAR,
+		400:  awslogs.RetentionDays_THIRTEEN_MONTHS,
+		545:  awslogs.RetentionDays_EIGHTEEN_MONTHS,
+		731:  awslogs.RetentionDays_TWO_YEARS,
+		1096: awslogs.RetentionDays_THREE_YEARS,
+		1827: awslogs.RetentionDays_FIVE_YEARS,
+	}
+	retention, ok := retentions[days]
+	if !ok {
+		panic(fmt.Sprintf("Unsupported log retention days: %d", days))
+	}
+	return retention
+}
+
+// validateDatabaseRole Global Databaseでの役割とデプロイ先リージョンを検証
+func validateDatabaseRole(stack awscdk.Stack, role string, dbConfig *config.DatabaseConfig) {
+	if role == "" {
+		role = config.DatabaseRolePrimary
+	}
+
+	switch role {
+	case config.DatabaseRolePrimary:
+	case config.DatabaseRoleSecondary:
+		if !dbConfig.Global.Enabled {
+			panic("Secondary database role requires Aurora Global Database to be enabled")
+		}
+	default:
+		panic("Invalid database role: " + role)
+	}
+
+	if !dbConfig.Global.Enabled || *awscdk.Token_IsUnresolved(stack.Region()) {
+		return
+	}
+
+	// プライマリはDRリージョン以外、セカンダリはDRリージョンにデプロイする
+	inSecondaryRegion := *stack.Region() == dbConfig.Global.SecondaryRegion
+	if inSecondaryRegion != (role == config.DatabaseRoleSecondary) {
+		panic(fmt.Sprintf("Database role %q cannot be deployed to %s (secondary region: %s)", role, *stack.Region(), dbConfig.Global.SecondaryRegion))
+	}
+}
+
+// primaryClusterIdentifier プライマリクラスターの識別子（CMKへの移行前の物理名）
+func primaryClusterIdentifier(envConfig *config.EnvironmentConfig) string {
+	return "service-" + envConfig.Name + "-aurora-cluster"
+}
+
+// globalClusterIdentifier Global Databaseの識別子
+func globalClusterIdentifier(envConfig *config.EnvironmentConfig) string {
+	return "service-" + envConfig.Name + "-aurora-global"
+}
+
+// secondaryClusterIdentifier DRリージョンのセカンダリクラスターの識別子
+func secondaryClusterIdentifier(envConfig *config.EnvironmentConfig) string {
+	return "service-" + envConfig.Name + "-aurora-secondary"
+}
+
+// createAuroraGlobalCluster 既存のクラスターをプライマリとしてGlobal Databaseを作成
+func createAuroraGlobalCluster(
+	stack awscdk.Stack,
+	envConfig *config.EnvironmentConfig,
+	dbConfig *config.DatabaseConfig,
+	cluster IAuroraCluster,
+) awsrds.CfnGlobalCluster {
+	globalCluster := awsrds.NewCfnGlobalCluster(stack, jsii.String("AuroraGlobalCluster"), &awsrds.CfnGlobalClusterProps{
+		GlobalClusterIdentifier:   jsii.String(globalClusterIdentifier(envConfig)),
+		SourceDbClusterIdentifier: cluster.ClusterIdentifier(),
+		DeletionProtection:        jsii.Bool(envConfig.Name == "production"),
+	})
+
+	awscdk.NewCfnOutput(stack, jsii.String("AuroraGlobalClusterId"), &awscdk.CfnOutputProps{
+		Value:       globalCluster.Ref(),
+		Description: jsii.String("Aurora Global Database Identifier"),
+		ExportName:  jsii.String("service-" + envConfig.Name + "-Aurora-Global-Cluster-Id"),
+	})
+	createGlobalDatabaseRunbookOutput(stack, envConfig, dbConfig)
+
+	return globalCluster
+}
+
+// createSecondaryDatabaseResources DRリージョンにGlobal Databaseのセカンダリクラスターを作成
+// 認証情報・RDS Proxy・キャッシュ・S3はプライマリリージョンのみで管理する
+func createSecondaryDatabaseResources(
+	stack awscdk.Stack,
+	props *StorageStackProps,
+	envConfig *config.EnvironmentConfig,
+	securityMatrix *config.SecurityMatrixConfig,
+	dbConfig *config.DatabaseConfig,
+	vpc awsec2.IVpc,
+	dataKeys *networkConstruct.DataKeys,
+) awsrds.CfnDBCluster {
+	// 昇格後はDRリージョンのECSタスクからクラスターに直接接続
+	securityGroups := getStorageSecurityGroups(stack, props, envConfig, securityMatrix)
+	securityGroups.AllowAppToDatabase(dbConfig.Port, dbConfig.ProtocolName())
+
+	subnetGroup := createDatabaseSubnetGroup(stack, envConfig, vpc, props.TestEnvFlag)
+
+	// パラメータグループはプライマリと同じ設定
+	engine := createAuroraEngine(dbConfig)
+	parameterGroup := awsrds.NewParameterGroup(stack, jsii.String("AuroraClusterParameterGroup"), &awsrds.ParameterGroupProps{
+		Engine:      engine,
+		Description: jsii.String("Cluster parameter group for service-" + envConfig.Name + " secondary (" + dbConfig.Engine + ")"),
+		Parameters:  toStringPtrMap(dbConfig.ClusterParameters()),
+	})
+	instanceParameterGroup := createAuroraInstanceParameterGroup(stack, envConfig, dbConfig, engine)
+
+	// セカンダリクラスター（マスターユーザーはGlobal Databaseから引き継ぐため指定しない）
+	cluster := awsrds.NewCfnDBCluster(stack, jsii.String("AuroraSecondaryCluster"), &awsrds.CfnDBClusterProps{
+		GlobalClusterIdentifier:     jsii.String(globalClusterIdentifier(envConfig)),
+		DbClusterIdentifier:         jsii.String(secondaryClusterIdentifier(envConfig)),
+		Engine:                      jsii.String(dbConfig.Engine),
+		EngineVersion:               jsii.String(dbConfig.FullEngineVersion()),
+		Port:                        jsii.Number(dbConfig.Port),
+		DbSubnetGroupName:           subnetGroup.SubnetGroupName(),
+		VpcSecurityGroupIds:         &[]*string{securityGroups.SecurityGroup("RDS").SecurityGroupId()},
+		DbClusterParameterGroupName: parameterGroup.BindToCluster(&awsrds.ParameterGroupClusterBindOptions{}).ParameterGroupName,
+		StorageEncrypted:            jsii.Bool(true),
+		KmsKeyId:                    keyArn(dataKeys.Key(config.DataClassDatabase)), // DRリージョンのKeyStackのキー
+		DeletionProtection:          jsii.Bool(envConfig.Name == "production"),
+		EnableCloudwatchLogsExports: jsii.Strings(dbConfig.LogExports()...),
+		ServerlessV2ScalingConfiguration: func() *awsrds.CfnDBCluster_ServerlessV2ScalingConfigurationProperty {
+			if !dbConfig.Capacity.UsesServerlessV2() {
+				return nil
 			}
-			// 実環境ではCross-stack参照
-			return &[]*string{
-				awscdk.Fn_ImportValue(jsii.String("service-" + envConfig.Name + "-RDS-SG-Id")),
+			return &awsrds.CfnDBCluster_ServerlessV2ScalingConfigurationProperty{
+				MinCapacity: jsii.Number(dbConfig.Capacity.MinACU),
+				MaxCapacity: jsii.Number(dbConfig.Capacity.MaxACU),
 			}
 		}(),
+	})
+	awscdk.Tags_Of(cluster).Add(jsii.String("Component"), jsii.String("Database"), nil)
 
-		// ポート設定
-		Port: jsii.Number(6379),
+	// Readerインスタンス（0の場合はヘッドレス）
+	instanceClass := "db.serverless"
+	if dbConfig.Capacity.Mode == config.DatabaseCapacityProvisioned {
+		instanceClass = "db." + dbConfig.Capacity.InstanceClass
+	}
+	for i := 1; i <= dbConfig.Global.SecondaryInstanceCount; i++ {
+		instance := awsrds.NewCfnDBInstance(stack, jsii.String(fmt.Sprintf("AuroraSecondaryInstance%d", i)), &awsrds.CfnDBInstanceProps{
+			DbClusterIdentifier: cluster.Ref(),
+			DbInstanceClass:     jsii.String(instanceClass),
+			Engine:              jsii.String(dbConfig.Engine),
+			PubliclyAccessible:  jsii.Bool(false),
+			DbParameterGroupName: func() *string {
+				if instanceParameterGroup == nil {
+					return nil
+				}
+				return instanceParameterGroup.BindToInstance(&awsrds.ParameterGroupInstanceBindOptions{}).ParameterGroupName
+			}(),
+		})
+		instance.AddDependency(cluster)
+	}
+
+	// DRリージョンのエンドポイント（昇格後にApplicationStackのDB_HOSTとして使用）
+	awscdk.NewCfnOutput(stack, jsii.String("AuroraSecondaryClusterEndpoint"), &awscdk.CfnOutputProps{
+		Value:       cluster.AttrEndpointAddress(),
+		Description: jsii.String("Aurora Global Database Secondary Cluster Endpoint (writable after promotion)"),
+		ExportName:  jsii.String("service-" + envConfig.Name + "-Aurora-Secondary-Endpoint"),
+	})
+	awscdk.NewCfnOutput(stack, jsii.String("AuroraSecondaryReaderEndpoint"), &awscdk.CfnOutputProps{
+		Value:       cluster.AttrReadEndpointAddress(),
+		Description: jsii.String("Aurora Global Database Secondary Cluster Reader Endpoint"),
+		ExportName:  jsii.String("service-" + envConfig.Name + "-Aurora-Secondary-Reader-Endpoint"),
+	})
+	createGlobalDatabaseRunbookOutput(stack, envConfig, dbConfig)
+
+	return cluster
+}
 
-		// 自動フェイルオーバー
-		AutomaticFailoverEnabled: jsii.Bool(numNodes > 1),
-		MultiAzEnabled:           jsii.Bool(envConfig.Name != "development"),
+// createGlobalDatabaseRunbookOutput セカンダリクラスターへの昇格手順を出力（プライマリ・セカンダリ両方のStackに出力）
+func createGlobalDatabaseRunbookOutput(stack awscdk.Stack, envConfig *config.EnvironmentConfig, dbConfig *config.DatabaseConfig) {
+	region := dbConfig.Global.SecondaryRegion
+	secondaryArn := "arn:aws:rds:" + region + ":${AWS::AccountId}:cluster:" + secondaryClusterIdentifier(envConfig)
+	target := " --global-cluster-identifier " + globalClusterIdentifier(envConfig) + " --target-db-cluster-identifier " + secondaryArn
+
+	steps := []string{
+		"1. Planned switchover (no data loss): aws rds switchover-global-cluster --region " + region + target,
+		"2. Regional outage: aws rds failover-global-cluster --region " + region + target + " --allow-data-loss",
+	}
+	if dbConfig.Global.SecondaryInstanceCount == 0 {
+		steps = append(steps, "3. Headless secondary: add a DB instance to "+secondaryClusterIdentifier(envConfig)+" before failover")
+	}
+	steps = append(steps, fmt.Sprintf("%d. Deploy ApplicationStack in %s with DB_HOST = export service-%s-Aurora-Secondary-Endpoint", len(steps)+1, region, envConfig.Name))
+
+	awscdk.NewCfnOutput(stack, jsii.String("AuroraGlobalFailoverRunbook"), &awscdk.CfnOutputProps{
+		Value:       awscdk.Fn_Sub(jsii.String(strings.Join(steps, " / ")), nil),
+		Description: jsii.String("Promotion runbook for the Aurora Global Database secondary cluster"),
+	})
+}
+
+// createMajorVersionUpgradeRunbookOutput メジャーバージョンアップグレードの移行手順を出力
+func createMajorVersionUpgradeRunbookOutput(stack awscdk.Stack, envConfig *config.EnvironmentConfig, dbConfig *config.DatabaseConfig, encryption *config.EncryptionConfig) {
+	// アップグレード対象はデプロイ済みのクラスター（CMKへの移行後は新しい識別子）
+	clusterIdentifier := encryption.ResourceName(config.DataClassDatabase, primaryClusterIdentifier(envConfig))
+	steps := []string{
+		"1. Before deploying: aws rds create-db-cluster-snapshot --db-cluster-identifier " + clusterIdentifier +
+			" --db-cluster-snapshot-identifier " + clusterIdentifier + "-before-" + strings.ReplaceAll(dbConfig.EngineVersion, ".", "-"),
+		"2. Clusters created as 5.7.12 must be on Aurora MySQL 2.11 or later; upgrade the minor version first if required",
+		"3. Deploy StorageStack (writer and readers restart during the upgrade); on failure check upgrade-prechecks.log in the cluster logs",
+		"4. Existing tables keep their character set and collation; convert them in a migration if required",
+		"5. After every environment is upgraded, set AllowMajorVersionUpgrade to false in GetDatabaseEngineConfig",
+	}
+
+	awscdk.NewCfnOutput(stack, jsii.String("AuroraMajorVersionUpgradeRunbook"), &awscdk.CfnOutputProps{
+		Value:       jsii.String(strings.Join(steps, " / ")),
+		Description: jsii.String("Migration runbook for the Aurora major version upgrade to " + dbConfig.FullEngineVersion()),
+	})
+}
+
+// createEncryptionMigrationRunbookOutput CMKへの移行手順を出力（既存のリソースは置換できないため新しい物理名で作成）
+func createEncryptionMigrationRunbookOutput(stack awscdk.Stack, environment string, envConfig *config.EnvironmentConfig, encryption *config.EncryptionConfig) {
+	steps := []string{}
+	addStep := func(step string) {
+		steps = append(steps, fmt.Sprintf("%d. %s", len(steps)+1, step))
+	}
+
+	if encryption.Migration.RestoreFromSnapshots {
+		addStep("Stop writes by scaling the ECS service to 0")
+	}
+	// スナップショットは移行前の既存のリソースから取得し、新しい物理名のリソースに復元
+	if encryption.RestoresFromSnapshot(config.DataClassDatabase) {
+		sourceIdentifier := primaryClusterIdentifier(envConfig)
+		addStep("aws rds create-db-cluster-snapshot --db-cluster-identifier " + sourceIdentifier +
+			" --db-cluster-snapshot-identifier " + encryption.MigrationSnapshotName(sourceIdentifier) +
+			" (restored into " + encryption.ResourceName(config.DataClassDatabase, sourceIdentifier) + ")")
+	}
+	if encryption.RestoresFromSnapshot(config.DataClassCache) {
+		sourceName := cacheClusterName(envConfig)
+		addStep("aws elasticache create-snapshot --replication-group-id " + sourceName + " --snapshot-name " + encryption.MigrationSnapshotName(sourceName) +
+			" (restored into " + encryption.ResourceName(config.DataClassCache, sourceName) + ")")
+	}
+	repositoryName := "service-" + environment
+	if newName := encryption.ResourceName(config.DataClassArtifacts, repositoryName); newName != repositoryName {
+		addStep("Deploy KeyStack and copy the images from ECR repository " + repositoryName + " to " + newName + " before deploying ApplicationStack")
+	}
+	addStep("Deploy StorageStack and ApplicationStack (Aurora, ElastiCache and ECR are created with the -" + encryption.Migration.NameSuffix + " suffix)")
+	addStep("Old resources that CloudFormation cannot delete (Aurora with deletion protection, retained or non-empty ECR repositories) must be deleted manually after verification")
+
+	awscdk.NewCfnOutput(stack, jsii.String("EncryptionMigrationRunbook"), &awscdk.CfnOutputProps{
+		Value:       jsii.String(strings.Join(steps, " / ")),
+		Description: jsii.String("Migration runbook for re-creating resources with customer managed keys"),
+	})
+}
+
+// createElastiCacheCluster ElastiCacheクラスターを作成（認証情報のシークレットも作成）
+func createElastiCacheCluster(
+	stack awscdk.Stack,
+	envConfig *config.EnvironmentConfig,
+	cacheConfig *config.CacheConfig,
+	vpc awsec2.IVpc,
+	securityGroup awsec2.ISecurityGroup,
+	dataKeys *networkConstruct.DataKeys,
+) (*networkConstruct.CacheCluster, awssecretsmanager.ISecret) {
+	// 認証設定（AUTHトークンまたはユーザーグループ、認証情報はアプリケーション用のシークレットで共有）
+	var authToken *string
+	var userGroupId *string
+	var cacheSecret awssecretsmanager.ISecret
+	if cacheConfig.Auth.Mode == config.CacheAuthRBAC {
+		var userGroup awselasticache.CfnUserGroup
+		userGroup, cacheSecret = createCacheUserGroup(stack, envConfig, cacheConfig, dataKeys.Key(config.DataClassSecrets))
+		userGroupId = userGroup.Ref()
+	} else {
+		cacheSecret = createCacheAuthTokenSecret(stack, envConfig, dataKeys.Key(config.DataClassSecrets))
+		authToken = cacheSecret.SecretValueFromJson(jsii.String("password")).UnsafeUnwrap()
+	}
+
+	// CMKへの移行時は移行前のスナップショットから復元
+	var snapshotName string
+	if dataKeys.Config.RestoresFromSnapshot(config.DataClassCache) {
+		snapshotName = dataKeys.Config.MigrationSnapshotName(cacheClusterName(envConfig))
+	}
+
+	cache := networkConstruct.NewCacheCluster(stack, "Redis", &networkConstruct.CacheClusterProps{
+		Vpc: vpc,
+		VpcSubnets: &awsec2.SubnetSelection{
+			SubnetType: awsec2.SubnetType_PRIVATE_WITH_EGRESS,
+		},
+		Config:       cacheConfig,
+		ClusterName:  dataKeys.Config.ResourceName(config.DataClassCache, cacheClusterName(envConfig)),
+		Description:  "Redis cluster for service " + envConfig.Name,
+		SnapshotName: snapshotName,
+
+		// セキュリティグループ（キャッシュ専用、ティア定義で管理）
+		SecurityGroups: []awsec2.ISecurityGroup{securityGroup},
+
+		AuthToken:   authToken,
+		UserGroupId: userGroupId,
+		MultiAz:     envConfig.Name != "development",
+		KmsKey:      dataKeys.Key(config.DataClassCache),
 
 		// バックアップ設定
-		SnapshotRetentionLimit: func() *float64 {
+		SnapshotRetentionDays: func() int {
 			switch envConfig.Name {
-			case "development":
-				return jsii.Number(1)
 			case "staging":
-				return jsii.Number(3)
+				return 3
 			case "production":
-				return jsii.Number(7)
+				return 7
 			default:
-				return jsii.Number(1)
+				return 1
 			}
 		}(),
-		SnapshotWindow: jsii.String("03:00-05:00"), // JST 12:00-14:00
+		SnapshotWindow: "03:00-05:00", // JST 12:00-14:00
 
 		// メンテナンスウィンドウ
-		PreferredMaintenanceWindow: jsii.String("sun:05:00-sun:06:00"), // JST日曜14:00-15:00
+		PreferredMaintenanceWindow: "sun:05:00-sun:06:00", // JST日曜14:00-15:00
+	})
+
+	// コンストラクト導入前の論理IDを維持（名前付きリソースのため置き換えはできない、CMKへの移行時は新しい名前で作成）
+	cache.SubnetGroup.OverrideLogicalId(jsii.String("RedisSubnetGroup"))
+	cache.ParameterGroup.OverrideLogicalId(jsii.String("RedisParameterGroup"))
+	cache.ReplicationGroup.OverrideLogicalId(jsii.String("RedisCluster"))
+
+	return cache, cacheSecret
+}
+
+// cacheClusterName ElastiCacheのレプリケーショングループID（CMKへの移行前の物理名）
+func cacheClusterName(envConfig *config.EnvironmentConfig) string {
+	return "service-" + envConfig.Name + "-redis"
+}
+
+// createCacheAuthTokenSecret ElastiCacheのAUTHトークンを生成
+// AUTHトークンで使用できない記号（" / @）を含まないよう記号は除外する
+func createCacheAuthTokenSecret(stack awscdk.Stack, envConfig *config.EnvironmentConfig, encryptionKey awskms.IKey) awssecretsmanager.ISecret {
+	return awssecretsmanager.NewSecret(stack, jsii.String("RedisAuthTokenSecret"), &awssecretsmanager.SecretProps{
+		SecretName:    jsii.String("service-" + envConfig.Name + "-redis-credentials"),
+		Description:   jsii.String("ElastiCache Redis AUTH token"),
+		EncryptionKey: encryptionKey,
+		GenerateSecretString: &awssecretsmanager.SecretStringGenerator{
+			SecretStringTemplate: jsii.String(`{"username":"default"}`),
+			GenerateStringKey:    jsii.String("password"),
+			PasswordLength:       jsii.Number(64),
+			ExcludePunctuation:   jsii.Bool(true),
+		},
+	})
+}
+
+// createCacheUserGroup ElastiCacheのユーザーグループを作成（読み書き・読み取り専用ユーザー）
+// 戻り値のシークレットはアプリケーションが使用する読み書きユーザーの認証情報
+func createCacheUserGroup(
+	stack awscdk.Stack,
+	envConfig *config.EnvironmentConfig,
+	cacheConfig *config.CacheConfig,
+	encryptionKey awskms.IKey,
+) (awselasticache.CfnUserGroup, awssecretsmanager.ISecret) {
+	auth := cacheConfig.Auth
+
+	// ユーザーグループには"default"ユーザーが必須のため、無効化したユーザーを登録
+	defaultUser := awselasticache.NewCfnUser(stack, jsii.String("RedisDefaultUser"), &awselasticache.CfnUserProps{
+		UserId:             jsii.String("service-" + envConfig.Name + "-redis-default"),
+		UserName:           jsii.String("default"),
+		Engine:             jsii.String(cacheConfig.Engine),
+		AccessString:       jsii.String("off -@all"),
+		NoPasswordRequired: jsii.Bool(true),
+	})
+
+	// 読み書きユーザーのシークレットはAUTHトークンと同じ名前（ApplicationStackの参照先は認証方式によらない）
+	readWriteUser, readWriteSecret := createCacheUser(stack, envConfig, cacheConfig, "RedisReadWriteUser", auth.ReadWriteUsername,
+		"on ~* &* +@all -@admin", "service-"+envConfig.Name+"-redis-credentials", encryptionKey)
+	readOnlyUser, readOnlySecret := createCacheUser(stack, envConfig, cacheConfig, "RedisReadOnlyUser", auth.ReadOnlyUsername,
+		"on ~* -@all +@read", "service-"+envConfig.Name+"-redis-readonly-credentials", encryptionKey)
+
+	userGroup := awselasticache.NewCfnUserGroup(stack, jsii.String("RedisUserGroup"), &awselasticache.CfnUserGroupProps{
+		UserGroupId: jsii.String("service-" + envConfig.Name + "-redis-users"),
+		Engine:      jsii.String(cacheConfig.Engine),
+		UserIds:     &[]*string{defaultUser.Ref(), readWriteUser.Ref(), readOnlyUser.Ref()},
+	})
+
+	// パスワードのローテーション
+	if auth.RotationDays > 0 {
+		rotation := createCacheUserRotationFunction(stack, envConfig, []awselasticache.CfnUser{readWriteUser, readOnlyUser})
+		for _, secret := range []awssecretsmanager.Secret{readWriteSecret, readOnlySecret} {
+			secret.AddRotationSchedule(jsii.String("Rotation"), &awssecretsmanager.RotationScheduleOptions{
+				RotationLambda:     rotation,
+				AutomaticallyAfter: awscdk.Duration_Days(jsii.Number(auth.RotationDays)),
+			})
+		}
+	}
+
+	awscdk.NewCfnOutput(stack, jsii.String("RedisReadOnlySecretArn"), &awscdk.CfnOutputProps{
+		Value:       readOnlySecret.SecretArn(),
+		Description: jsii.String("ElastiCache Redis Read-only User Secret ARN"),
+		ExportName:  jsii.String("service-" + envConfig.Name + "-Redis-ReadOnly-Secret-Arn"),
+	})
+
+	return userGroup, readWriteSecret
+}
+
+// createCacheUser パスワード認証のElastiCacheユーザーと認証情報を作成
+// シークレットのuser_idはローテーション時のユーザー特定に使用
+func createCacheUser(
+	stack awscdk.Stack,
+	envConfig *config.EnvironmentConfig,
+	cacheConfig *config.CacheConfig,
+	id string,
+	username string,
+	accessString string,
+	secretName string,
+	encryptionKey awskms.IKey,
+) (awselasticache.CfnUser, awssecretsmanager.Secret) {
+	userId := "service-" + envConfig.Name + "-redis-" + username
+
+	secret := awssecretsmanager.NewSecret(stack, jsii.String(id+"Secret"), &awssecretsmanager.SecretProps{
+		SecretName:    jsii.String(secretName),
+		Description:   jsii.String("ElastiCache Redis user " + username),
+		EncryptionKey: encryptionKey,
+		GenerateSecretString: &awssecretsmanager.SecretStringGenerator{
+			SecretStringTemplate: jsii.String(fmt.Sprintf(`{"username":%q,"user_id":%q}`, username, userId)),
+			GenerateStringKey:    jsii.String("password"),
+			PasswordLength:       jsii.Number(64),
+			ExcludePunctuation:   jsii.Bool(true),
+		},
+	})
+
+	user := awselasticache.NewCfnUser(stack, jsii.String(id), &awselasticache.CfnUserProps{
+		UserId:       jsii.String(userId),
+		UserName:     jsii.String(username),
+		Engine:       jsii.String(cacheConfig.Engine),
+		AccessString: jsii.String(accessString),
+		// CloudFormationのキー名で指定（型付きのプロパティはキー名が変換されない）
+		AuthenticationMode: map[string]interface{}{
+			"Type":      "password",
+			"Passwords": []*string{secret.SecretValueFromJson(jsii.String("password")).UnsafeUnwrap()},
+		},
 	})
 
-	// 依存関係設定
-	replicationGroup.AddDependency(subnetGroup)
+	return user, secret
+}
+
+// createCacheUserRotationFunction ElastiCacheユーザーのパスワードをローテーションするLambdaを作成
+// ElastiCache用のローテーションはCDKで提供されていないため、ModifyUserでパスワードを更新する
+func createCacheUserRotationFunction(stack awscdk.Stack, envConfig *config.EnvironmentConfig, users []awselasticache.CfnUser) awslambda.IFunction {
+	rotation := awslambda.NewFunction(stack, jsii.String("RedisUserRotationFunction"), &awslambda.FunctionProps{
+		FunctionName: jsii.String("service-" + envConfig.Name + "-redis-user-rotation"),
+		Description:  jsii.String("Rotates ElastiCache Redis user passwords"),
+		Runtime:      awslambda.Runtime_PYTHON_3_12(),
+		Handler:      jsii.String("index.handler"),
+		Code:         awslambda.Code_FromInline(jsii.String(cacheUserRotationHandler)),
+		Timeout:      awscdk.Duration_Minutes(jsii.Number(5)),
+	})
 
-	return replicationGroup
+	userArns := []*string{}
+	for _, user := range users {
+		userArns = append(userArns, user.AttrArn())
+	}
+	rotation.AddToRolePolicy(awsiam.NewPolicyStatement(&awsiam.PolicyStatementProps{
+		Actions:   jsii.Strings("elasticache:ModifyUser", "elasticache:DescribeUsers"),
+		Resources: &userArns,
+	}))
+
+	return rotation
 }
 
+// cacheUserRotationHandler ElastiCacheユーザーのローテーション処理
+// setSecretでは現在と新しいパスワードの両方を有効にし、起動済みのタスクは次回のローテーションまで接続できる
+const cacheUserRotationHandler = `import json
+import time
+
+import boto3
+
+secrets = boto3.client("secretsmanager")
+elasticache = boto3.client("elasticache")
+
+
+def handler(event, context):
+    arn, token, step = event["SecretId"], event["ClientRequestToken"], event["Step"]
+
+    if step == "createSecret":
+        try:
+            secrets.get_secret_value(SecretId=arn, VersionId=token, VersionStage="AWSPENDING")
+        except secrets.exceptions.ResourceNotFoundException:
+            secret = get_secret(arn, "AWSCURRENT")
+            secret["password"] = secrets.get_random_password(PasswordLength=64, ExcludePunctuation=True)["RandomPassword"]
+            secrets.put_secret_value(SecretId=arn, ClientRequestToken=token, SecretString=json.dumps(secret), VersionStages=["AWSPENDING"])
+    elif step == "setSecret":
+        current = get_secret(arn, "AWSCURRENT")
+        pending = get_secret(arn, "AWSPENDING", token)
+        wait_for_user(pending["user_id"])
+        elasticache.modify_user(
+            UserId=pending["user_id"],
+            AuthenticationMode={"Type": "password", "Passwords": [current["password"], pending["password"]]},
+        )
+    elif step == "testSecret":
+        wait_for_user(get_secret(arn, "AWSPENDING", token)["user_id"])
+    elif step == "finishSecret":
+        versions = secrets.describe_secret(SecretId=arn)["VersionIdsToStages"]
+        current = next(v for v, stages in versions.items() if "AWSCURRENT" in stages)
+        if current != token:
+            secrets.update_secret_version_stage(SecretId=arn, VersionStage="AWSCURRENT", MoveToVersionId=token, RemoveFromVersionId=current)
+    else:
+        raise ValueError("Invalid step: " + step)
+
+
+def get_secret(arn, stage, token=None):
+    kwargs = {"SecretId": arn, "VersionStage": stage}
+    if token:
+        kwargs["VersionId"] = token
+    return json.loads(secrets.get_secret_value(**kwargs)["SecretString"])
+
+
+def wait_for_user(user_id):
+    for _ in range(50):
+        if elasticache.describe_users(UserId=user_id)["Users"][0]["Status"] == "active":
+            return
+        time.sleep(5)
+    raise TimeoutError("ElastiCache user is not active: " + user_id)
+`
+
 // 他の関数は既存コードと同じ...
 
-// getAuroraInstanceCount 環境別のAuroraインスタンス数を取得
-func getAuroraInstanceCount(environment string) float64 {
-	switch environment {
-	case "development":
-		return 1
-	case "staging":
-		return 2
-	case "production":
-		return 3
-	default:
-		return 1
+// createDatabaseAppSecret アプリケーションユーザーの認証情報を作成
+// ユーザー自体はcreateDatabaseAppUserのタスクでマスターユーザーにより作成する（CloudFormationでは作成不可）
+func createDatabaseAppSecret(
+	stack awscdk.Stack,
+	envConfig *config.EnvironmentConfig,
+	dbConfig *config.DatabaseConfig,
+	cluster IAuroraCluster,
+	adminSecret awsrds.DatabaseSecret,
+	rotationSecurityGroup awsec2.ISecurityGroup,
+	encryptionKey awskms.IKey,
+) awssecretsmanager.ISecret {
+	appSecret := awsrds.NewDatabaseSecret(stack, jsii.String("AuroraAppSecret"), &awsrds.DatabaseSecretProps{
+		Username:          jsii.String(dbConfig.Credentials.AppUsername),
+		SecretName:        jsii.String("service-" + envConfig.Name + "-db-credentials"),
+		MasterSecret:      adminSecret, // マルチユーザーローテーションで使用
+		ExcludeCharacters: jsii.String(`"@/\`),
+		EncryptionKey:     encryptionKey,
+	})
+
+	// クラスターに関連付け（host・port・dbnameがシークレットに追加される）
+	attachedSecret := appSecret.Attach(cluster)
+
+	// マルチユーザーローテーション（ローテーション中も接続が途切れない）
+	if dbConfig.Credentials.RotationDays > 0 {
+		cluster.AddRotationMultiUser(jsii.String("AppUserRotation"), &awsrds.RotationMultiUserOptions{
+			Secret:             attachedSecret,
+			AutomaticallyAfter: awscdk.Duration_Days(jsii.Number(dbConfig.Credentials.RotationDays)),
+			SecurityGroup:      rotationSecurityGroup,
+		})
 	}
+
+	return attachedSecret
 }
 
-// getAuroraInstanceType 環境別のAuroraインスタンスタイプを取得
-func getAuroraInstanceType(environment string) awsec2.InstanceType {
-	switch environment {
-	case "development":
-		return awsec2.InstanceType_Of(awsec2.InstanceClass_T3, awsec2.InstanceSize_SMALL)
-	case "staging":
-		return awsec2.InstanceType_Of(awsec2.InstanceClass_T3, awsec2.InstanceSize_MEDIUM)
-	case "production":
-		return awsec2.InstanceType_Of(awsec2.InstanceClass_R5, awsec2.InstanceSize_LARGE)
-	default:
-		return awsec2.InstanceType_Of(awsec2.InstanceClass_T3, awsec2.InstanceSize_SMALL)
+// createDatabaseAppUser アプリケーションユーザーと権限をマスターユーザーで作成するタスクをデプロイ時に実行
+// タスクが失敗した場合はデプロイを失敗させる（ユーザー作成・権限変更・クラスター置換時に再実行）
+func createDatabaseAppUser(
+	stack awscdk.Stack,
+	environment string,
+	envConfig *config.EnvironmentConfig,
+	dbConfig *config.DatabaseConfig,
+	vpc awsec2.IVpc,
+	cluster IAuroraCluster,
+	adminSecret awssecretsmanager.ISecret,
+	appSecret awssecretsmanager.ISecret,
+	securityGroups *networkConstruct.ServiceSecurityGroups,
+) *networkConstruct.OneShotTask {
+	sgName := "Service-" + envConfig.Name + "-DatabaseUser-SG"
+
+	// マスターユーザーでクラスターに直接接続（RDS Proxyにはマスターユーザーを登録しない）
+	securityGroup := awsec2.NewSecurityGroup(stack, jsii.String("DatabaseUserSecurityGroup"), &awsec2.SecurityGroupProps{
+		Vpc:               vpc,
+		Description:       jsii.String("Security group for database user provisioning task"),
+		SecurityGroupName: jsii.String(sgName),
+		AllowAllOutbound:  jsii.Bool(!envConfig.RestrictEgress),
+	})
+	awscdk.Tags_Of(securityGroup).Add(jsii.String("Name"), jsii.String(sgName), nil)
+	securityGroups.AllowFrom(securityGroup, "RDS", awsec2.Port_Tcp(jsii.Number(dbConfig.Port)), "Allow database traffic from user provisioning task")
+
+	ecsCluster := awsecs.NewCluster(stack, jsii.String("DatabaseUserCluster"), &awsecs.ClusterProps{
+		Vpc:         vpc,
+		ClusterName: jsii.String("service-" + envConfig.Name + "-database-user"),
+	})
+
+	taskDefinition := awsecs.NewFargateTaskDefinition(stack, jsii.String("DatabaseUserTaskDefinition"), &awsecs.FargateTaskDefinitionProps{
+		Family:         jsii.String("service-" + envConfig.Name + "-database-user"),
+		Cpu:            jsii.Number(256),
+		MemoryLimitMiB: jsii.Number(512),
+	})
+
+	script := databaseAppUserScript(dbConfig)
+	taskDefinition.AddContainer(jsii.String("database-user"), &awsecs.ContainerDefinitionOptions{
+		Image:   publicContainerImage(stack, "DatabaseClientImageRepository", taskDefinition, environment, dbConfig.ClientImage(), envConfig.RestrictEgress),
+		Command: jsii.Strings("sh", "-c", script),
+		Environment: &map[string]*string{
+			"DB_HOST":     cluster.ClusterEndpoint().Hostname(),
+			"DB_PORT":     jsii.String(strconv.Itoa(dbConfig.Port)),
+			"DB_DATABASE": jsii.String(config.DatabaseName),
+		},
+		Secrets: &map[string]awsecs.Secret{
+			"DB_ADMIN_USERNAME": awsecs.Secret_FromSecretsManager(adminSecret, jsii.String("username")),
+			"DB_ADMIN_PASSWORD": awsecs.Secret_FromSecretsManager(adminSecret, jsii.String("password")),
+			"DB_USERNAME":       awsecs.Secret_FromSecretsManager(appSecret, jsii.String("username")),
+			"DB_PASSWORD":       awsecs.Secret_FromSecretsManager(appSecret, jsii.String("password")),
+		},
+		Logging: awsecs.LogDriver_AwsLogs(&awsecs.AwsLogDriverProps{
+			StreamPrefix: jsii.String("database-user"),
+			LogRetention: toLogRetentionDays(dbConfig.Monitoring.LogRetentionDays),
+		}),
+	})
+
+	// イメージ・シークレットの取得とログ出力はECSティアの経路を使用（Egress制限時はVPCエンドポイント経由）
+	task := networkConstruct.NewOneShotTask(stack, "DatabaseAppUser", &networkConstruct.OneShotTaskProps{
+		Cluster:        ecsCluster,
+		TaskDefinition: taskDefinition,
+		VpcSubnets: &awsec2.SubnetSelection{
+			SubnetType: awsec2.SubnetType_PRIVATE_WITH_EGRESS,
+		},
+		SecurityGroups: []awsec2.ISecurityGroup{securityGroup, securityGroups.SecurityGroup("ECS")},
+		Trigger:        awscdk.Fn_Join(jsii.String(":"), jsii.Strings(fmt.Sprintf("%x", sha256.Sum256([]byte(script))), *cluster.ClusterResourceIdentifier())),
+		Timeout:        awscdk.Duration_Minutes(jsii.Number(15)),
+	})
+	task.Node().AddDependency(cluster, appSecret)
+
+	return task
+}
+
+// databaseAppUserScript アプリケーションユーザーの作成・パスワード設定・権限付与のスクリプト
+// 既存ユーザー（復元したスナップショットのユーザーを含む）はパスワードをシークレットの値に合わせる
+func databaseAppUserScript(dbConfig *config.DatabaseConfig) string {
+	privileges := strings.Join(dbConfig.Credentials.AppPrivileges, ", ")
+
+	// パスワードはSQLの文字列リテラル用にシングルクォートをエスケープ
+	header := `set -eu
+APP_PASSWORD=$(printf '%s' "$DB_PASSWORD" | sed "s/'/''/g")
+`
+	if dbConfig.IsPostgreSQL() {
+		return header + `export PGPASSWORD="$DB_ADMIN_PASSWORD" PGSSLMODE=require
+psql --host="$DB_HOST" --port="$DB_PORT" --username="$DB_ADMIN_USERNAME" --dbname="$DB_DATABASE" -v ON_ERROR_STOP=1 <<SQL
+DO \$\$ BEGIN
+  IF NOT EXISTS (SELECT FROM pg_roles WHERE rolname = '$DB_USERNAME') THEN
+    CREATE ROLE "$DB_USERNAME" LOGIN;
+  END IF;
+END \$\$;
+ALTER ROLE "$DB_USERNAME" WITH LOGIN PASSWORD '$APP_PASSWORD';
+GRANT CONNECT ON DATABASE "$DB_DATABASE" TO "$DB_USERNAME";
+GRANT USAGE ON SCHEMA public TO "$DB_USERNAME";
+GRANT ` + privileges + ` ON ALL TABLES IN SCHEMA public TO "$DB_USERNAME";
+ALTER DEFAULT PRIVILEGES FOR ROLE "$DB_ADMIN_USERNAME" IN SCHEMA public GRANT ` + privileges + ` ON TABLES TO "$DB_USERNAME";
+SQL
+`
 	}
+	return header + `export MYSQL_PWD="$DB_ADMIN_PASSWORD"
+mysql --host="$DB_HOST" --port="$DB_PORT" --user="$DB_ADMIN_USERNAME" --ssl-mode=REQUIRED <<SQL
+CREATE USER IF NOT EXISTS '$DB_USERNAME'@'%' IDENTIFIED BY '$APP_PASSWORD';
+ALTER USER '$DB_USERNAME'@'%' IDENTIFIED BY '$APP_PASSWORD';
+GRANT ` + privileges + ` ON $DB_DATABASE.* TO '$DB_USERNAME'@'%';
+SQL
+`
 }
 
-// getRedisConfiguration 環境別のRedis設定を取得
-func getRedisConfiguration(environment string) (string, float64) {
-	switch environment {
-	case "development":
-		return "cache.t3.micro", 1
-	case "staging":
-		return "cache.t3.small", 2
-	case "production":
-		return "cache.r6g.large", 3
-	default:
-		return "cache.t3.micro", 1
+// createRotationSecurityGroup Egress制限時に認証情報ローテーション用Lambdaが使用するセキュリティグループを作成
+// クラスターへの接続はローテーション追加時に許可され、Secrets ManagerへはVPCエンドポイント経由で接続する
+func createRotationSecurityGroup(
+	stack awscdk.Stack,
+	envConfig *config.EnvironmentConfig,
+	vpc awsec2.IVpc,
+	securityGroups *networkConstruct.ServiceSecurityGroups,
+) awsec2.ISecurityGroup {
+	if !envConfig.RestrictEgress {
+		return nil
+	}
+
+	sgName := "Service-" + envConfig.Name + "-SecretRotation-SG"
+	securityGroup := awsec2.NewSecurityGroup(stack, jsii.String("SecretRotationSecurityGroup"), &awsec2.SecurityGroupProps{
+		Vpc:               vpc,
+		Description:       jsii.String("Security group for secret rotation functions"),
+		SecurityGroupName: jsii.String(sgName),
+		AllowAllOutbound:  jsii.Bool(false),
+	})
+	awscdk.Tags_Of(securityGroup).Add(jsii.String("Name"), jsii.String(sgName), nil)
+	securityGroups.AllowFrom(securityGroup, "Endpoints", awsec2.Port_Tcp(jsii.Number(443)), "Allow HTTPS from secret rotation to VPC endpoints")
+
+	return securityGroup
+}
+
+// createDatabaseProxy Aurora Clusterの前段にRDS Proxyを作成
+// ProxyはアプリケーションユーザーのシークレットでAuroraに接続する（マスターユーザーは登録しない）
+func createDatabaseProxy(
+	stack awscdk.Stack,
+	envConfig *config.EnvironmentConfig,
+	dbConfig *config.DatabaseConfig,
+	vpc awsec2.IVpc,
+	cluster IAuroraCluster,
+	appSecret awssecretsmanager.ISecret,
+) awsrds.DatabaseProxy {
+	proxyConfig := dbConfig.Proxy
+	sgName := "Service-" + envConfig.Name + "-DBProxy-SG"
+
+	// Proxy専用のセキュリティグループ（ECS → Proxy → Auroraの経路のみ許可）
+	securityGroup := awsec2.NewSecurityGroup(stack, jsii.String("DatabaseProxySecurityGroup"), &awsec2.SecurityGroupProps{
+		Vpc:               vpc,
+		Description:       jsii.String("Security group for RDS Proxy"),
+		SecurityGroupName: jsii.String(sgName),
+		AllowAllOutbound:  jsii.Bool(!envConfig.RestrictEgress),
+	})
+	awscdk.Tags_Of(securityGroup).Add(jsii.String("Name"), jsii.String(sgName), nil)
+
+	proxy := awsrds.NewDatabaseProxy(stack, jsii.String("AuroraProxy"), &awsrds.DatabaseProxyProps{
+		ProxyTarget: awsrds.ProxyTarget_FromCluster(cluster),
+		Secrets:     &[]awssecretsmanager.ISecret{appSecret},
+		DbProxyName: jsii.String("service-" + envConfig.Name + "-db-proxy"),
+
+		// VPC設定
+		Vpc: vpc,
+		VpcSubnets: &awsec2.SubnetSelection{
+			SubnetType: awsec2.SubnetType_PRIVATE_WITH_EGRESS,
+		},
+		SecurityGroups: &[]awsec2.ISecurityGroup{securityGroup},
+
+		// 認証設定
+		IamAuth:    jsii.Bool(proxyConfig.IAMAuth),
+		RequireTLS: jsii.Bool(proxyConfig.RequireTLS),
+
+		// 接続プール設定
+		MaxConnectionsPercent:     jsii.Number(proxyConfig.MaxConnectionsPercent),
+		MaxIdleConnectionsPercent: jsii.Number(proxyConfig.MaxIdleConnectionsPercent),
+		BorrowTimeout:             awscdk.Duration_Seconds(jsii.Number(proxyConfig.BorrowTimeoutSeconds)),
+		IdleClientTimeout:         awscdk.Duration_Minutes(jsii.Number(proxyConfig.IdleClientTimeoutMinutes)),
+	})
+
+	awscdk.Tags_Of(proxy).Add(jsii.String("Component"), jsii.String("Database"), nil)
+
+	return proxy
+}
+
+// createAuroraEngine 設定からAuroraエンジンを作成
+func createAuroraEngine(dbConfig *config.DatabaseConfig) awsrds.IClusterEngine {
+	if dbConfig.IsPostgreSQL() {
+		return awsrds.DatabaseClusterEngine_AuroraPostgres(&awsrds.AuroraPostgresClusterEngineProps{
+			Version: awsrds.AuroraPostgresEngineVersion_Of(jsii.String(dbConfig.FullEngineVersion()), jsii.String(dbConfig.MajorVersion()), nil),
+		})
+	}
+	return awsrds.DatabaseClusterEngine_AuroraMysql(&awsrds.AuroraMysqlClusterEngineProps{
+		Version: awsrds.AuroraMysqlEngineVersion_Of(jsii.String(dbConfig.FullEngineVersion()), jsii.String(dbConfig.MajorVersion())),
+	})
+}
+
+// toStringPtrMap map[string]stringをCDK用のポインタマップに変換
+func toStringPtrMap(values map[string]string) *map[string]*string {
+	result := make(map[string]*string, len(values))
+	for key, value := range values {
+		result[key] = jsii.String(value)
 	}
+	return &result
+}
+
+// createAuroraInstanceParameterGroup インスタンスパラメータグループを作成（パラメータ未設定の場合はnil）
+func createAuroraInstanceParameterGroup(
+	stack awscdk.Stack,
+	envConfig *config.EnvironmentConfig,
+	dbConfig *config.DatabaseConfig,
+	engine awsrds.IClusterEngine,
+) awsrds.IParameterGroup {
+	if len(dbConfig.Parameters.Instance) == 0 {
+		return nil
+	}
+	return awsrds.NewParameterGroup(stack, jsii.String("AuroraInstanceParameterGroup"), &awsrds.ParameterGroupProps{
+		Engine:      engine,
+		Description: jsii.String("Instance parameter group for service-" + envConfig.Name + " (" + dbConfig.Engine + ")"),
+		Parameters:  toStringPtrMap(dbConfig.Parameters.Instance),
+	})
+}
+
+// createAuroraWriter 容量モードに応じたWriterインスタンスを作成
+func createAuroraWriter(capacity config.DatabaseCapacityConfig, parameterGroup awsrds.IParameterGroup) awsrds.IClusterInstance {
+	if capacity.Mode == config.DatabaseCapacityServerless {
+		return awsrds.ClusterInstance_ServerlessV2(jsii.String("writer"), &awsrds.ServerlessV2ClusterInstanceProps{
+			ParameterGroup: parameterGroup,
+		})
+	}
+	return awsrds.ClusterInstance_Provisioned(jsii.String("writer"), &awsrds.ProvisionedClusterInstanceProps{
+		InstanceType:   awsec2.NewInstanceType(jsii.String(capacity.InstanceClass)),
+		ParameterGroup: parameterGroup,
+	})
+}
+
+// createAuroraReaders 容量モードに応じたReaderインスタンスを作成
+func createAuroraReaders(capacity config.DatabaseCapacityConfig, parameterGroup awsrds.IParameterGroup) *[]awsrds.IClusterInstance {
+	// 単一インスタンスの場合はReadersなし
+	readers := make([]awsrds.IClusterInstance, 0, capacity.InstanceCount-1)
+	for i := 1; i < capacity.InstanceCount; i++ {
+		id := jsii.String(fmt.Sprintf("reader%d", i))
+		if capacity.Mode == config.DatabaseCapacityProvisioned {
+			readers = append(readers, awsrds.ClusterInstance_Provisioned(id, &awsrds.ProvisionedClusterInstanceProps{
+				InstanceType:   awsec2.NewInstanceType(jsii.String(capacity.InstanceClass)),
+				ParameterGroup: parameterGroup,
+			}))
+			continue
+		}
+
+		// Serverless v2 Reader（昇格ティア0-1の場合はWriterの容量に追従）
+		readers = append(readers, awsrds.ClusterInstance_ServerlessV2(id, &awsrds.ServerlessV2ClusterInstanceProps{
+			ScaleWithWriter: jsii.Bool(capacity.ReadersScaleWithWriter),
+			ParameterGroup:  parameterGroup,
+		}))
+	}
+	return &readers
 }
 
 // createS3Buckets S3バケット群を作成
-func createS3Buckets(stack awscdk.Stack, envConfig *config.EnvironmentConfig) (awss3.Bucket, awss3.Bucket, awss3.Bucket) {
-	// 静的アセット用バケット
-	staticBucket := createStaticAssetsBucket(stack, envConfig)
+// 静的アセット（CloudFrontで公開）・ログ（ALBのアクセスログはSSE-S3のみ対応）はS3管理キーで暗号化
+func createS3Buckets(stack awscdk.Stack, envConfig *config.EnvironmentConfig, bucketsConfig config.StorageBucketsConfig, dataKeys *networkConstruct.DataKeys) (awss3.Bucket, awss3.Bucket, awss3.Bucket) {
+	// ログ用バケット（他のバケットのサーバーアクセスログの出力先）
+	logsBucket := createLogsBucket(stack, envConfig, bucketsConfig.Logs)
+	excludeUnversionedBucketFromBackup(logsBucket, envConfig, bucketsConfig.Logs)
 
-	// ログ用バケット
-	logsBucket := createLogsBucket(stack, envConfig)
+	// 静的アセット用バケット
+	staticBucket := createStaticAssetsBucket(stack, envConfig, bucketsConfig.StaticAssets)
+	if bucketsConfig.StaticAssets.ServerAccessLogs {
+		enableServerAccessLogs(logsBucket, staticBucket, "static-assets")
+	}
 
 	// バックアップ用バケット
-	backupsBucket := createBackupsBucket(stack, envConfig)
+	backupsBucket := createBackupsBucket(stack, envConfig, bucketsConfig.Backups, dataKeys.Key(config.DataClassBackups))
+	if bucketsConfig.Backups.ServerAccessLogs {
+		enableServerAccessLogs(logsBucket, backupsBucket, "backups")
+	}
 
 	return staticBucket, logsBucket, backupsBucket
 }
 
 // createStaticAssetsBucket 静的アセット用S3バケットを作成
-func createStaticAssetsBucket(stack awscdk.Stack, envConfig *config.EnvironmentConfig) awss3.Bucket {
+func createStaticAssetsBucket(stack awscdk.Stack, envConfig *config.EnvironmentConfig, bucketConfig config.BucketConfig) awss3.Bucket {
 	bucket := awss3.NewBucket(stack, jsii.String("StaticAssetsBucket"), &awss3.BucketProps{
 		BucketName:       jsii.String("service-" + envConfig.Name + "-static-assets"),
-		Versioned:        jsii.Bool(true),
+		Versioned:        jsii.Bool(bucketConfig.Versioned),
 		BucketKeyEnabled: jsii.Bool(true),
+		LifecycleRules:   toLifecycleRules(bucketConfig.Lifecycle),
 
 		// セキュリティ設定
 		BlockPublicAccess: awss3.BlockPublicAccess_BLOCK_ALL(),
@@ -422,12 +1622,181 @@ func createStaticAssetsBucket(stack awscdk.Stack, envConfig *config.EnvironmentC
 	return bucket
 }
 
+// createStaticAssetsCDN 静的アセット用バケットをオリジンとするCloudFrontを作成
+func createStaticAssetsCDN(stack awscdk.Stack, envConfig *config.EnvironmentConfig, cdnConfig *config.CDNConfig, staticBucket awss3.Bucket) *networkConstruct.StaticAssetsCDN {
+	cdn := networkConstruct.NewStaticAssetsCDN(stack, "StaticAssetsCDN", &networkConstruct.StaticAssetsCDNProps{
+		Bucket: staticBucket,
+		Config: cdnConfig,
+		Name:   "service-" + envConfig.Name + "-static-assets",
+	})
+	awscdk.Tags_Of(cdn.Construct).Add(jsii.String("Component"), jsii.String("CDN"), nil)
+
+	awscdk.NewCfnOutput(stack, jsii.String("StaticAssetsDistributionId"), &awscdk.CfnOutputProps{
+		Value:       cdn.Distribution.DistributionId(),
+		Description: jsii.String("Static Assets CloudFront Distribution ID (for cache invalidation)"),
+		ExportName:  jsii.String("service-" + envConfig.Name + "-Static-Distribution-Id"),
+	})
+	awscdk.NewCfnOutput(stack, jsii.String("StaticAssetsDistributionDomainName"), &awscdk.CfnOutputProps{
+		Value:       cdn.Distribution.DistributionDomainName(),
+		Description: jsii.String("Static Assets CloudFront Distribution Domain Name"),
+	})
+	awscdk.NewCfnOutput(stack, jsii.String("StaticAssetsUrl"), &awscdk.CfnOutputProps{
+		Value:       cdn.URL(),
+		Description: jsii.String("Static Assets URL (ASSET_URL for the application)"),
+		ExportName:  jsii.String("service-" + envConfig.Name + "-Static-Assets-Url"),
+	})
+
+	return cdn
+}
+
+// createFileSystem 暗号化したEFSとアプリケーションのパスごとのアクセスポイントを作成
+// マウントターゲットはECSタスクと同じサブネットに作成し、バックアップはBackupタグでAWS Backupの対象とする
+func createFileSystem(
+	stack awscdk.Stack,
+	envConfig *config.EnvironmentConfig,
+	fileSystemConfig *config.FileSystemConfig,
+	vpc awsec2.IVpc,
+	encryptionKey awskms.IKey,
+) (awsefs.FileSystem, map[string]awsefs.AccessPoint) {
+	sgName := "Service-" + envConfig.Name + "-EFS-SG"
+
+	// マウントターゲット専用のセキュリティグループ（ECSタスクからのNFSのみ許可）
+	securityGroup := awsec2.NewSecurityGroup(stack, jsii.String("FileSystemSecurityGroup"), &awsec2.SecurityGroupProps{
+		Vpc:               vpc,
+		Description:       jsii.String("Security group for EFS mount targets"),
+		SecurityGroupName: jsii.String(sgName),
+		AllowAllOutbound:  jsii.Bool(!envConfig.RestrictEgress),
+	})
+	awscdk.Tags_Of(securityGroup).Add(jsii.String("Name"), jsii.String(sgName), nil)
+
+	fileSystem := awsefs.NewFileSystem(stack, jsii.String("FileSystem"), &awsefs.FileSystemProps{
+		FileSystemName: jsii.String("service-" + envConfig.Name + "-efs"),
+		Vpc:            vpc,
+		VpcSubnets: &awsec2.SubnetSelection{
+			SubnetType: awsec2.SubnetType_PRIVATE_WITH_EGRESS,
+		},
+		SecurityGroup: securityGroup,
+
+		// 暗号化設定（ファイルのCMKを使用しない場合はAWS管理キー）
+		Encrypted: jsii.Bool(true),
+		KmsKey:    encryptionKey,
+
+		PerformanceMode: toFileSystemPerformanceMode(fileSystemConfig.PerformanceMode),
+		ThroughputMode:  toFileSystemThroughputMode(fileSystemConfig.ThroughputMode),
+		LifecyclePolicy: toFileSystemLifecyclePolicy(fileSystemConfig.TransitionToIADays),
+
+		// 削除保護設定
+		RemovalPolicy: func() awscdk.RemovalPolicy {
+			if envConfig.Name == "production" {
+				return awscdk.RemovalPolicy_RETAIN
+			}
+			return awscdk.RemovalPolicy_DESTROY
+		}(),
+	})
+	for key, value := range envConfig.Tags {
+		awscdk.Tags_Of(fileSystem).Add(jsii.String(key), jsii.String(value), nil)
+	}
+	awscdk.Tags_Of(fileSystem).Add(jsii.String("Component"), jsii.String("FileSystem"), nil)
+
+	awscdk.NewCfnOutput(stack, jsii.String("FileSystemId"), &awscdk.CfnOutputProps{
+		Value:       fileSystem.FileSystemId(),
+		Description: jsii.String("EFS File System ID"),
+		ExportName:  jsii.String(fileSystemExportName(envConfig)),
+	})
+
+	// アクセスポイントごとにルートディレクトリとPOSIXユーザーを固定
+	accessPoints := make(map[string]awsefs.AccessPoint)
+	for _, ap := range fileSystemConfig.AccessPoints {
+		uid := jsii.String(strconv.Itoa(ap.UID))
+		gid := jsii.String(strconv.Itoa(ap.GID))
+
+		accessPoint := fileSystem.AddAccessPoint(jsii.String(fileSystemAccessPointId(ap.Name)), &awsefs.AccessPointOptions{
+			Path: jsii.String(ap.Path),
+			CreateAcl: &awsefs.Acl{
+				OwnerUid:    uid,
+				OwnerGid:    gid,
+				Permissions: jsii.String(ap.Permissions),
+			},
+			PosixUser: &awsefs.PosixUser{
+				Uid: uid,
+				Gid: gid,
+			},
+		})
+		awscdk.Tags_Of(accessPoint).Add(jsii.String("Name"), jsii.String("service-"+envConfig.Name+"-"+ap.Name), nil)
+		accessPoints[ap.Name] = accessPoint
+
+		awscdk.NewCfnOutput(stack, jsii.String(fileSystemAccessPointId(ap.Name)+"Id"), &awscdk.CfnOutputProps{
+			Value:       accessPoint.AccessPointId(),
+			Description: jsii.String("EFS Access Point ID for " + ap.Path),
+			ExportName:  jsii.String(fileSystemAccessPointExportName(envConfig, ap.Name)),
+		})
+	}
+
+	return fileSystem, accessPoints
+}
+
+// fileSystemExportName EFSのファイルシステムIDのExport名（ApplicationStackから参照）
+func fileSystemExportName(envConfig *config.EnvironmentConfig) string {
+	return "service-" + envConfig.Name + "-EFS-Id"
+}
+
+// fileSystemAccessPointExportName アクセスポイントIDのExport名（ApplicationStackから参照）
+func fileSystemAccessPointExportName(envConfig *config.EnvironmentConfig, name string) string {
+	return "service-" + envConfig.Name + "-EFS-" + name + "-Access-Point-Id"
+}
+
+// fileSystemAccessPointId アクセスポイントのコンストラクトID（UploadsAccessPoint など）
+func fileSystemAccessPointId(name string) string {
+	id := ""
+	for _, part := range strings.Split(name, "-") {
+		id += strings.ToUpper(part[:1]) + part[1:]
+	}
+	return id + "AccessPoint"
+}
+
+// toFileSystemPerformanceMode 設定のパフォーマンスモードをEFSのパフォーマンスモードに変換
+func toFileSystemPerformanceMode(performanceMode string) awsefs.PerformanceMode {
+	switch performanceMode {
+	case config.FileSystemPerformanceModeGeneralPurpose:
+		return awsefs.PerformanceMode_GENERAL_PURPOSE
+	case config.FileSystemPerformanceModeMaxIO:
+		return awsefs.PerformanceMode_MAX_IO
+	default:
+		panic("Unsupported file system performance mode: " + performanceMode)
+	}
+}
+
+// toFileSystemThroughputMode 設定のスループットモードをEFSのスループットモードに変換
+func toFileSystemThroughputMode(throughputMode string) awsefs.ThroughputMode {
+	switch throughputMode {
+	case config.FileSystemThroughputModeBursting:
+		return awsefs.ThroughputMode_BURSTING
+	case config.FileSystemThroughputModeElastic:
+		return awsefs.ThroughputMode_ELASTIC
+	default:
+		panic("Unsupported file system throughput mode: " + throughputMode)
+	}
+}
+
+// toFileSystemLifecyclePolicy 日数を低頻度アクセスクラスへの移行ポリシーに変換（移行しない場合は空）
+func toFileSystemLifecyclePolicy(days int) awsefs.LifecyclePolicy {
+	switch {
+	case days == 0:
+		return ""
+	case days == 1:
+		return awsefs.LifecyclePolicy_AFTER_1_DAY
+	default:
+		return awsefs.LifecyclePolicy(fmt.Sprintf("AFTER_%d_DAYS", days))
+	}
+}
+
 // createLogsBucket ログ用S3バケットを作成
-func createLogsBucket(stack awscdk.Stack, envConfig *config.EnvironmentConfig) awss3.Bucket {
+func createLogsBucket(stack awscdk.Stack, envConfig *config.EnvironmentConfig, bucketConfig config.BucketConfig) awss3.Bucket {
 	bucket := awss3.NewBucket(stack, jsii.String("LogsBucket"), &awss3.BucketProps{
 		BucketName:       jsii.String("service-" + envConfig.Name + "-logs"),
-		Versioned:        jsii.Bool(false), // ログは版管理不要
+		Versioned:        jsii.Bool(bucketConfig.Versioned),
 		BucketKeyEnabled: jsii.Bool(true),
+		LifecycleRules:   toLifecycleRules(bucketConfig.Lifecycle),
 
 		// セキュリティ設定
 		BlockPublicAccess: awss3.BlockPublicAccess_BLOCK_ALL(),
@@ -439,35 +1808,318 @@ func createLogsBucket(stack awscdk.Stack, envConfig *config.EnvironmentConfig) a
 		RemovalPolicy: awscdk.RemovalPolicy_DESTROY,
 	})
 
+	// ALBのアクセスログ・接続ログ（ApplicationStackのALBが出力）
+	allowALBLogDelivery(stack, bucket, config.LogsPrefixALBAccess)
+	allowALBLogDelivery(stack, bucket, config.LogsPrefixALBConnection)
+
 	// タグ追加
 	addS3BucketTags(bucket, envConfig, "Logs")
 
 	return bucket
 }
 
+// allowALBLogDelivery ALBのログ配信をバケットポリシーで許可（ログの暗号化はSSE-S3のみ対応）
+func allowALBLogDelivery(stack awscdk.Stack, logsBucket awss3.Bucket, prefix string) {
+	objects := logsBucket.ArnForObjects(jsii.String(prefix + "/AWSLogs/" + *stack.Account() + "/*"))
+
+	logsBucket.AddToResourcePolicy(awsiam.NewPolicyStatement(&awsiam.PolicyStatementProps{
+		Actions:    jsii.Strings("s3:PutObject"),
+		Principals: &[]awsiam.IPrincipal{elbLogDeliveryPrincipal(stack)},
+		Resources:  &[]*string{objects},
+	}))
+	logsBucket.AddToResourcePolicy(awsiam.NewPolicyStatement(&awsiam.PolicyStatementProps{
+		Actions:    jsii.Strings("s3:PutObject"),
+		Principals: &[]awsiam.IPrincipal{awsiam.NewServicePrincipal(jsii.String("delivery.logs.amazonaws.com"), nil)},
+		Resources:  &[]*string{objects},
+		Conditions: &map[string]interface{}{
+			"StringEquals": map[string]interface{}{"s3:x-amz-acl": "bucket-owner-full-control"},
+		},
+	}))
+	logsBucket.AddToResourcePolicy(awsiam.NewPolicyStatement(&awsiam.PolicyStatementProps{
+		Actions:    jsii.Strings("s3:GetBucketAcl"),
+		Principals: &[]awsiam.IPrincipal{awsiam.NewServicePrincipal(jsii.String("delivery.logs.amazonaws.com"), nil)},
+		Resources:  &[]*string{logsBucket.BucketArn()},
+	}))
+}
+
+// elbLogDeliveryPrincipal ALBのログ配信元（2022年8月以前のリージョンはリージョン別のELBアカウント）
+// リージョンが確定していない場合は新しいリージョン向けのサービスプリンシパル
+func elbLogDeliveryPrincipal(stack awscdk.Stack) awsiam.IPrincipal {
+	if region := stack.Region(); !*awscdk.Token_IsUnresolved(region) {
+		if account := regioninfo.RegionInfo_Get(region).Elbv2Account(); account != nil {
+			return awsiam.NewAccountPrincipal(account)
+		}
+	}
+	return awsiam.NewServicePrincipal(jsii.String("logdelivery.elasticloadbalancing.amazonaws.com"), nil)
+}
+
+// excludeUnversionedBucketFromBackup バージョニング無効のバケットをAWS Backupの対象から除外
+// S3のバックアップはバージョニングが前提のため、スタック全体に付与されるBackupタグを上書きする
+func excludeUnversionedBucketFromBackup(bucket awss3.Bucket, envConfig *config.EnvironmentConfig, bucketConfig config.BucketConfig) {
+	if bucketConfig.Versioned || envConfig.Tags[config.BackupSelectionTagKey] != config.BackupSelectionTagValue {
+		return
+	}
+	awscdk.Tags_Of(bucket).Add(jsii.String(config.BackupSelectionTagKey), jsii.String("NotRequired"), nil)
+}
+
+// enableServerAccessLogs S3サーバーアクセスログをログ用バケットに出力
+// ログ用バケットへの書き込みは出力元のバケットからのみ許可する（ログ用バケット自身のログによるループを防止）
+func enableServerAccessLogs(logsBucket awss3.Bucket, bucket awss3.Bucket, name string) {
+	prefix := config.LogsPrefixS3Access + "/" + name + "/"
+
+	bucket.Node().DefaultChild().(awss3.CfnBucket).SetLoggingConfiguration(&awss3.CfnBucket_LoggingConfigurationProperty{
+		DestinationBucketName: logsBucket.BucketName(),
+		LogFilePrefix:         jsii.String(prefix),
+	})
+
+	logsBucket.AddToResourcePolicy(awsiam.NewPolicyStatement(&awsiam.PolicyStatementProps{
+		Actions:    jsii.Strings("s3:PutObject"),
+		Principals: &[]awsiam.IPrincipal{awsiam.NewServicePrincipal(jsii.String("logging.s3.amazonaws.com"), nil)},
+		Resources:  &[]*string{logsBucket.ArnForObjects(jsii.String(prefix + "*"))},
+		Conditions: &map[string]interface{}{
+			"ArnLike":      map[string]interface{}{"aws:SourceArn": bucket.BucketArn()},
+			"StringEquals": map[string]interface{}{"aws:SourceAccount": awscdk.Aws_ACCOUNT_ID()},
+		},
+	}))
+}
+
 // createBackupsBucket バックアップ用S3バケットを作成
-func createBackupsBucket(stack awscdk.Stack, envConfig *config.EnvironmentConfig) awss3.Bucket {
+func createBackupsBucket(stack awscdk.Stack, envConfig *config.EnvironmentConfig, bucketConfig config.BucketConfig, encryptionKey awskms.IKey) awss3.Bucket {
+	// レプリケーション（DRリージョン・バックアップ専用アカウント）
+	var replicationRole awsiam.IRole
+	var replicationRules *[]*awss3.ReplicationRule
+	if len(bucketConfig.Replication.Destinations) > 0 {
+		replicationRole = createBackupsReplicationRole(stack, envConfig, bucketConfig.Replication)
+		replicationRules = toReplicationRules(stack, bucketConfig.Replication)
+	}
+
+	// Object Lockは既存のバケットに有効化できないため、新しいバケット名が必要
+	bucketName := "service-" + envConfig.Name + "-backups"
+	objectLock := bucketConfig.ObjectLock
+	if objectLock.Enabled {
+		if objectLock.BucketName == bucketName {
+			panic("Object Lock cannot be enabled on the existing bucket " + bucketName + ": specify a new bucket name")
+		}
+		bucketName = objectLock.BucketName
+	}
+
 	bucket := awss3.NewBucket(stack, jsii.String("BackupsBucket"), &awss3.BucketProps{
-		BucketName:       jsii.String("service-" + envConfig.Name + "-backups"),
-		Versioned:        jsii.Bool(true),
+		BucketName:       jsii.String(bucketName),
+		Versioned:        jsii.Bool(bucketConfig.Versioned),
 		BucketKeyEnabled: jsii.Bool(true),
+		LifecycleRules:   toLifecycleRules(bucketConfig.Lifecycle),
 
 		// セキュリティ設定
 		BlockPublicAccess: awss3.BlockPublicAccess_BLOCK_ALL(),
 
-		// 暗号化設定（バックアップは強力な暗号化）
-		Encryption: awss3.BucketEncryption_KMS_MANAGED,
+		// 暗号化設定（バックアップは強力な暗号化、CMK未使用の場合はAWS管理キー）
+		Encryption: func() awss3.BucketEncryption {
+			if encryptionKey != nil {
+				return awss3.BucketEncryption_KMS
+			}
+			return awss3.BucketEncryption_KMS_MANAGED
+		}(),
+		EncryptionKey: encryptionKey,
 
 		// 削除保護設定（バックアップは常に保持）
 		RemovalPolicy: awscdk.RemovalPolicy_RETAIN,
+
+		// Object Lock（WORM）設定（無効の場合は既存のバケットが置き換わらないようプロパティ自体を出力しない）
+		ObjectLockEnabled: func() *bool {
+			if !objectLock.Enabled {
+				return nil
+			}
+			return jsii.Bool(true)
+		}(),
+		ObjectLockDefaultRetention: toObjectLockRetention(objectLock),
+
+		// レプリケーション設定
+		ReplicationRole:  replicationRole,
+		ReplicationRules: replicationRules,
 	})
 
 	// タグ追加
 	addS3BucketTags(bucket, envConfig, "Backups")
 
+	if replicationRole != nil {
+		// 元のバケットの読み取り・宛先バケットへの書き込み権限
+		destinations := []*awss3.GrantReplicationPermissionDestinationProps{}
+		for _, rule := range *replicationRules {
+			destinations = append(destinations, &awss3.GrantReplicationPermissionDestinationProps{Bucket: rule.Destination})
+		}
+		bucket.GrantReplicationPermission(replicationRole, &awss3.GrantReplicationPermissionProps{
+			Destinations: &destinations,
+		})
+
+		// 別アカウントの宛先バケットのバケットポリシーでこのロールを許可する
+		awscdk.NewCfnOutput(stack, jsii.String("BackupsReplicationRoleArn"), &awscdk.CfnOutputProps{
+			Value:       replicationRole.RoleArn(),
+			Description: jsii.String("IAM role used for S3 replication of the backups bucket"),
+			ExportName:  jsii.String("service-" + envConfig.Name + "-Backups-Replication-Role-Arn"),
+		})
+	}
+
 	return bucket
 }
 
+// toObjectLockRetention Object Lockの既定の保持設定（無効の場合はnil）
+func toObjectLockRetention(objectLock config.BucketObjectLockConfig) awss3.ObjectLockRetention {
+	if !objectLock.Enabled {
+		return nil
+	}
+	duration := awscdk.Duration_Days(jsii.Number(objectLock.RetentionDays))
+	if objectLock.Mode == config.ObjectLockCompliance {
+		return awss3.ObjectLockRetention_Compliance(duration)
+	}
+	return awss3.ObjectLockRetention_Governance(duration)
+}
+
+// createBackupsReplicationRole バックアップ用バケットのレプリケーションロールを作成
+// 宛先のKMSキーはエイリアスで指定するため、キーの権限はエイリアス名の条件で付与
+func createBackupsReplicationRole(stack awscdk.Stack, envConfig *config.EnvironmentConfig, replication config.BucketReplicationConfig) awsiam.IRole {
+	role := awsiam.NewRole(stack, jsii.String("BackupsReplicationRole"), &awsiam.RoleProps{
+		RoleName:    jsii.String("service-" + envConfig.Name + "-backups-replication"),
+		AssumedBy:   awsiam.NewServicePrincipal(jsii.String("s3.amazonaws.com"), nil),
+		Description: jsii.String("S3 replication role for service-" + envConfig.Name + "-backups"),
+	})
+
+	// 元のオブジェクトの復号はGrantReplicationPermissionでバケットのCMKに付与（CMK未使用の場合はAWS管理キー（aws/s3）のキーポリシーで許可済み）
+	for _, d := range replication.Destinations {
+		role.AddToPolicy(awsiam.NewPolicyStatement(&awsiam.PolicyStatementProps{
+			Actions:   jsii.Strings("kms:Encrypt", "kms:GenerateDataKey"),
+			Resources: &[]*string{awscdk.Fn_Sub(jsii.String("arn:${AWS::Partition}:kms:"+replicationRegion(d)+":"+replicationAccount(d)+":key/*"), nil)},
+			Conditions: &map[string]interface{}{
+				"ForAnyValue:StringEquals": map[string]interface{}{
+					"kms:ResourceAliases": d.KmsKeyAlias,
+				},
+			},
+		}))
+	}
+
+	return role
+}
+
+// toReplicationRules レプリケーション設定をS3のレプリケーションルールに変換（先頭の宛先ほど優先度が高い）
+func toReplicationRules(stack awscdk.Stack, replication config.BucketReplicationConfig) *[]*awss3.ReplicationRule {
+	rules := []*awss3.ReplicationRule{}
+	for i, d := range replication.Destinations {
+		attrs := &awss3.BucketAttributes{BucketName: jsii.String(d.BucketName)}
+		if d.Region != "" {
+			attrs.Region = jsii.String(d.Region)
+		}
+		if d.Account != "" {
+			attrs.Account = jsii.String(d.Account)
+		}
+		destination := awss3.Bucket_FromBucketAttributes(stack, jsii.String(fmt.Sprintf("BackupsReplicaBucket%d", i+1)), attrs)
+
+		rule := &awss3.ReplicationRule{
+			Id:          jsii.String(fmt.Sprintf("service-replication-%d", i+1)),
+			Priority:    jsii.Number(len(replication.Destinations) - i),
+			Destination: destination,
+
+			// 元のバケットのSSE-KMSオブジェクトを宛先のKMSキーで再暗号化
+			SseKmsEncryptedObjects: jsii.Bool(true),
+			KmsKey: awskms.Key_FromKeyArn(stack, jsii.String(fmt.Sprintf("BackupsReplicaKey%d", i+1)),
+				awscdk.Fn_Sub(jsii.String("arn:${AWS::Partition}:kms:"+replicationRegion(d)+":"+replicationAccount(d)+":"+d.KmsKeyAlias), nil)),
+
+			// 削除マーカーは複製しない（誤削除・ランサムウェア対策）
+			DeleteMarkerReplication: jsii.Bool(false),
+
+			AccessControlTransition: jsii.Bool(d.OwnerOverride),
+		}
+		if d.StorageClass != "" {
+			rule.StorageClass = toStorageClass(d.StorageClass)
+		}
+		if d.ReplicationTimeControl {
+			rule.ReplicationTimeControl = awss3.ReplicationTimeValue_FIFTEEN_MINUTES()
+			rule.Metrics = awss3.ReplicationTimeValue_FIFTEEN_MINUTES()
+		}
+		rules = append(rules, rule)
+	}
+	return &rules
+}
+
+// replicationRegion レプリケーション宛先のリージョン（Fn::Sub用、未指定の場合はStackのリージョン）
+func replicationRegion(d config.BucketReplicationDestinationConfig) string {
+	if d.Region == "" {
+		return "${AWS::Region}"
+	}
+	return d.Region
+}
+
+// replicationAccount レプリケーション宛先のアカウント（Fn::Sub用、未指定の場合はStackのアカウント）
+func replicationAccount(d config.BucketReplicationDestinationConfig) string {
+	if d.Account == "" {
+		return "${AWS::AccountId}"
+	}
+	return d.Account
+}
+
+// toLifecycleRules ライフサイクル設定をS3のライフサイクルルールに変換（設定がない場合はnil）
+func toLifecycleRules(lc config.BucketLifecycleConfig) *[]*awss3.LifecycleRule {
+	if lc.IsEmpty() {
+		return nil
+	}
+
+	rule := &awss3.LifecycleRule{
+		Id:      jsii.String("service-lifecycle"),
+		Enabled: jsii.Bool(true),
+	}
+
+	// 現行バージョン
+	if len(lc.Transitions) > 0 {
+		transitions := []*awss3.Transition{}
+		for _, t := range lc.Transitions {
+			transitions = append(transitions, &awss3.Transition{
+				StorageClass:    toStorageClass(t.StorageClass),
+				TransitionAfter: awscdk.Duration_Days(jsii.Number(t.Days)),
+			})
+		}
+		rule.Transitions = &transitions
+	}
+	if lc.ExpirationDays > 0 {
+		rule.Expiration = awscdk.Duration_Days(jsii.Number(lc.ExpirationDays))
+	}
+
+	// 非最新バージョン
+	if len(lc.NoncurrentTransitions) > 0 {
+		transitions := []*awss3.NoncurrentVersionTransition{}
+		for _, t := range lc.NoncurrentTransitions {
+			transitions = append(transitions, &awss3.NoncurrentVersionTransition{
+				StorageClass:    toStorageClass(t.StorageClass),
+				TransitionAfter: awscdk.Duration_Days(jsii.Number(t.Days)),
+			})
+		}
+		rule.NoncurrentVersionTransitions = &transitions
+	}
+	if lc.NoncurrentVersionExpirationDays > 0 {
+		rule.NoncurrentVersionExpiration = awscdk.Duration_Days(jsii.Number(lc.NoncurrentVersionExpirationDays))
+	}
+	if lc.NoncurrentVersionsToRetain > 0 {
+		rule.NoncurrentVersionsToRetain = jsii.Number(lc.NoncurrentVersionsToRetain)
+	}
+
+	if lc.AbortIncompleteMultipartUploadDays > 0 {
+		rule.AbortIncompleteMultipartUploadAfter = awscdk.Duration_Days(jsii.Number(lc.AbortIncompleteMultipartUploadDays))
+	}
+
+	return &[]*awss3.LifecycleRule{rule}
+}
+
+// toStorageClass 設定のストレージクラスをS3のストレージクラスに変換
+func toStorageClass(storageClass string) awss3.StorageClass {
+	switch storageClass {
+	case config.StorageClassInfrequentAccess:
+		return awss3.StorageClass_INFREQUENT_ACCESS()
+	case config.StorageClassGlacierInstantRetrieval:
+		return awss3.StorageClass_GLACIER_INSTANT_RETRIEVAL()
+	case config.StorageClassDeepArchive:
+		return awss3.StorageClass_DEEP_ARCHIVE()
+	default:
+		panic("Unsupported lifecycle storage class: " + storageClass)
+	}
+}
+
 // addS3BucketTags S3バケットにタグを追加
 func addS3BucketTags(bucket awss3.Bucket, envConfig *config.EnvironmentConfig, bucketType string) {
 	for key, value := range envConfig.Tags {
@@ -480,8 +2132,12 @@ func addS3BucketTags(bucket awss3.Bucket, envConfig *config.EnvironmentConfig, b
 // createStorageStackOutputs Cross-stack出力を作成
 func createStorageStackOutputs(
 	stack awscdk.Stack,
-	auroraCluster awsrds.DatabaseCluster,
-	elastiCache awselasticache.CfnReplicationGroup,
+	dbConfig *config.DatabaseConfig,
+	auroraCluster IAuroraCluster,
+	databaseSecret awssecretsmanager.ISecret,
+	databaseProxy awsrds.DatabaseProxy,
+	elastiCache *networkConstruct.CacheCluster,
+	cacheSecret awssecretsmanager.ISecret,
 	staticBucket awss3.Bucket,
 	logsBucket awss3.Bucket,
 	backupsBucket awss3.Bucket,
@@ -491,21 +2147,76 @@ func createStorageStackOutputs(
 	// Aurora関連の出力
 	awscdk.NewCfnOutput(stack, jsii.String("AuroraClusterEndpoint"), &awscdk.CfnOutputProps{
 		Value:       auroraCluster.ClusterEndpoint().Hostname(),
-		Description: jsii.String("Aurora MySQL Cluster Writer Endpoint"),
+		Description: jsii.String(dbConfig.EngineName() + " Cluster Writer Endpoint"),
 		ExportName:  jsii.String("service-" + environment + "-Aurora-Endpoint"),
 	})
 
 	awscdk.NewCfnOutput(stack, jsii.String("AuroraReaderEndpoint"), &awscdk.CfnOutputProps{
 		Value:       auroraCluster.ClusterReadEndpoint().Hostname(),
-		Description: jsii.String("Aurora MySQL Cluster Reader Endpoint"),
+		Description: jsii.String(dbConfig.EngineName() + " Cluster Reader Endpoint"),
 		ExportName:  jsii.String("service-" + environment + "-Aurora-Reader-Endpoint"),
 	})
 
-	// ElastiCache関連の出力
+	// アプリケーション用DB認証情報（ApplicationStackから参照）
+	awscdk.NewCfnOutput(stack, jsii.String("DatabaseSecretArn"), &awscdk.CfnOutputProps{
+		Value:       databaseSecret.SecretArn(),
+		Description: jsii.String("Aurora Application User Secret ARN"),
+		ExportName:  jsii.String("service-" + environment + "-DB-Secret-Arn"),
+	})
+
+	// RDS Proxy関連の出力（ApplicationStackのDB_HOST・IAM認証で参照）
+	var databaseProxyEndpoint string
+	if databaseProxy != nil {
+		awscdk.NewCfnOutput(stack, jsii.String("DatabaseProxyEndpoint"), &awscdk.CfnOutputProps{
+			Value:       databaseProxy.Endpoint(),
+			Description: jsii.String("RDS Proxy Endpoint"),
+			ExportName:  jsii.String("service-" + environment + "-DB-Proxy-Endpoint"),
+		})
+
+		awscdk.NewCfnOutput(stack, jsii.String("DatabaseProxyArn"), &awscdk.CfnOutputProps{
+			Value:       databaseProxy.DbProxyArn(),
+			Description: jsii.String("RDS Proxy ARN"),
+			ExportName:  jsii.String("service-" + environment + "-DB-Proxy-Arn"),
+		})
+
+		databaseProxyEndpoint = *databaseProxy.Endpoint()
+	}
+
+	// ElastiCache関連の出力（クラスターモード有効時は設定エンドポイントをApplicationStackに渡す）
+	// Export名はApplicationStackの参照を変えないようエンジンに関わらずRedisのまま
+	cacheEngine := elastiCache.Config.EngineName()
+	cacheEndpoint := elastiCache.Endpoint()
+	if configurationEndpoint := elastiCache.ConfigurationEndpoint(); configurationEndpoint != nil {
+		awscdk.NewCfnOutput(stack, jsii.String("ElastiCacheConfigurationEndpoint"), &awscdk.CfnOutputProps{
+			Value:       configurationEndpoint.Hostname,
+			Description: jsii.String("ElastiCache " + cacheEngine + " Configuration Endpoint (cluster mode enabled)"),
+			ExportName:  jsii.String("service-" + environment + "-Redis-Configuration-Endpoint"),
+		})
+	}
+	if readerEndpoint := elastiCache.ReaderEndpoint(); readerEndpoint != nil {
+		awscdk.NewCfnOutput(stack, jsii.String("ElastiCacheReaderEndpoint"), &awscdk.CfnOutputProps{
+			Value:       readerEndpoint.Hostname,
+			Description: jsii.String("ElastiCache " + cacheEngine + " Reader Endpoint"),
+			ExportName:  jsii.String("service-" + environment + "-Redis-Reader-Endpoint"),
+		})
+	}
+
 	awscdk.NewCfnOutput(stack, jsii.String("ElastiCacheEndpoint"), &awscdk.CfnOutputProps{
-		Value:       elastiCache.AttrPrimaryEndPointAddress(),
-		Description: jsii.String("ElastiCache Redis Primary Endpoint"),
-		ExportName:  jsii.String("service-" + environment + "-Redis-Endpoint"),
+		Value: cacheEndpoint.Hostname,
+		Description: func() *string {
+			if elastiCache.Config.Topology.ClusterMode {
+				return jsii.String("ElastiCache " + cacheEngine + " Configuration Endpoint")
+			}
+			return jsii.String("ElastiCache " + cacheEngine + " Primary Endpoint")
+		}(),
+		ExportName: jsii.String("service-" + environment + "-Redis-Endpoint"),
+	})
+
+	// アプリケーション用Redis認証情報（ApplicationStackから参照）
+	awscdk.NewCfnOutput(stack, jsii.String("RedisSecretArn"), &awscdk.CfnOutputProps{
+		Value:       cacheSecret.SecretArn(),
+		Description: jsii.String("ElastiCache " + cacheEngine + " Application Credentials Secret ARN"),
+		ExportName:  jsii.String("service-" + environment + "-Redis-Secret-Arn"),
 	})
 
 	// S3 Buckets関連の出力
@@ -531,7 +2242,10 @@ func createStorageStackOutputs(
 	return &StorageStackOutputs{
 		AuroraClusterEndpoint:  *auroraCluster.ClusterEndpoint().Hostname(),
 		AuroraReaderEndpoint:   *auroraCluster.ClusterReadEndpoint().Hostname(),
-		ElastiCacheEndpoint:    *elastiCache.AttrPrimaryEndPointAddress(),
+		DatabaseSecretArn:      *databaseSecret.SecretArn(),
+		DatabaseProxyEndpoint:  databaseProxyEndpoint,
+		ElastiCacheEndpoint:    *cacheEndpoint.Hostname,
+		RedisSecretArn:         *cacheSecret.SecretArn(),
 		StaticAssetsBucketName: *staticBucket.BucketName(),
 		LogsBucketName:         *logsBucket.BucketName(),
 		BackupsBucketName:      *backupsBucket.BucketName(),
@@ -22,6 +22,9 @@ type TestAppConfig struct {
 func CreateTestApp(config *TestAppConfig) awscdk.App {
 	app := awscdk.NewApp(nil)
 
+	// cdk.jsonと同じ機能フラグ（スナップショットからの復元時に未使用のシークレットを作成しない）
+	app.Node().SetContext(jsii.String("@aws-cdk/aws-rds:preventRenderingDeprecatedCredentials"), jsii.Bool(true))
+
 	if config != nil {
 		// 環境設定をコンテキストに追加
 		app.Node().SetContext(jsii.String("environment"), jsii.String(config.Environment))
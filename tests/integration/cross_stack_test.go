@@ -1,10 +1,13 @@
 package integration_test
 
 import (
+	"aws-ecs-fargate-go-cdk/internal/config"
 	"aws-ecs-fargate-go-cdk/internal/stacks"
 	"aws-ecs-fargate-go-cdk/tests/helpers"
+	"encoding/json"
 	"testing"
 
+	"github.com/aws/aws-cdk-go/awscdk/v2"
 	"github.com/aws/aws-cdk-go/awscdk/v2/assertions"
 	"github.com/aws/jsii-runtime-go"
 	"github.com/stretchr/testify/assert"
@@ -136,7 +139,7 @@ func TestSecurityGroupCrossStackReferences(t *testing.T) {
 
 	// Then: NetworkStackでセキュリティグループが作成されることを確認
 	networkTemplate := assertions.Template_FromStack(networkStack, nil)
-	networkTemplate.ResourceCountIs(jsii.String("AWS::EC2::SecurityGroup"), jsii.Number(3))
+	networkTemplate.ResourceCountIs(jsii.String("AWS::EC2::SecurityGroup"), jsii.Number(4))
 
 	// セキュリティグループ出力の確認
 	networkTemplate.HasOutput(jsii.String("ALBSecurityGroupId"), map[string]interface{}{
@@ -229,3 +232,222 @@ func TestEnvironmentSpecificConfigurations(t *testing.T) {
 		})
 	}
 }
+
+// TestRestrictedEgressIntegration Egress制限時に全Stackで依存先へのEgressのみが作成されることのテスト
+func TestRestrictedEgressIntegration(t *testing.T) {
+	// Given: 本番スナップショットから復元するステージング環境（マスキングタスクを含む）
+	app := helpers.CreateTestApp(&helpers.TestAppConfig{
+		Environment: "staging",
+		Region:      "ap-northeast-1",
+		Account:     "123456789012",
+	})
+	seed := config.DatabaseSeedConfig{
+		Mode:               config.DatabaseSeedSnapshot,
+		SnapshotIdentifier: "service-production-aurora-2026-10-01",
+		Masking: config.DatabaseMaskingConfig{
+			Enabled: true,
+			Image:   "public.ecr.aws/docker/library/php:8.3-cli",
+			Command: []string{"php", "/app/artisan", "db:mask-pii", "--force"},
+			CPU:     256,
+			Memory:  512,
+		},
+	}
+
+	// When: 全StackにEgress制限を指定（S3プレフィックスリストのLookup用にアカウント・リージョンを指定）
+	networkStack := stacks.NewNetworkStack(app, "RestrictedNetworkStack", &stacks.NetworkStackProps{
+		StackProps: awscdk.StackProps{
+			Env: &awscdk.Environment{
+				Account: jsii.String("123456789012"),
+				Region:  jsii.String("ap-northeast-1"),
+			},
+		},
+		Environment:    "staging",
+		RestrictEgress: true,
+	})
+
+	storageStack := stacks.NewStorageStack(app, "RestrictedStorageStack", &stacks.StorageStackProps{
+		Environment:    "staging",
+		VpcId:          "vpc-from-network-stack",
+		TestEnvFlag:    true,
+		DatabaseSeed:   &seed,
+		RestrictEgress: true,
+	})
+
+	applicationStack := stacks.NewApplicationStack(app, "RestrictedApplicationStack", &stacks.ApplicationStackProps{
+		Environment:    "staging",
+		VpcId:          "vpc-from-network-stack",
+		TestEnvFlag:    true,
+		RestrictEgress: true,
+	})
+
+	// Then: NetworkStackはECR Publicのプルスルーキャッシュを作成
+	networkTemplate := assertions.Template_FromStack(networkStack, nil)
+	networkTemplate.HasResourceProperties(jsii.String("AWS::ECR::PullThroughCacheRule"), map[string]interface{}{
+		"EcrRepositoryPrefix": "service-staging-ecr-public",
+		"UpstreamRegistryUrl": "public.ecr.aws",
+	})
+
+	// NetworkStack: セキュリティグループ定義のティア間ルール（ALB → ECS、ECS → VPCエンドポイント）と対になるEgress
+	for _, port := range []int{80, 443} {
+		networkTemplate.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroupEgress"), map[string]interface{}{
+			"DestinationSecurityGroupId": assertions.Match_AnyValue(),
+			"FromPort":                   port,
+		})
+	}
+
+	// ApplicationStack: ルールは作成せず、nginxはプルスルーキャッシュから取得
+	applicationTemplate := assertions.Template_FromStack(applicationStack, nil)
+	applicationTemplate.ResourceCountIs(jsii.String("AWS::EC2::SecurityGroupEgress"), jsii.Number(0))
+	assertCachedImage(t, applicationTemplate, "nginx-web", "/service-staging-ecr-public/docker/library/nginx:1.24-alpine")
+
+	// StorageStack: ECS → RDS / RDS Proxy / Cache / EFS、Proxy → RDS / VPCエンドポイント、マスキングタスク → RDSのEgress
+	storageTemplate := assertions.Template_FromStack(storageStack, nil)
+	for _, egress := range []map[string]interface{}{
+		{"GroupId": "sg-test-ecs-staging", "DestinationSecurityGroupId": "sg-test-rds-staging", "FromPort": 3306},
+		{"GroupId": "sg-test-ecs-staging", "FromPort": 3306},
+		{"GroupId": "sg-test-ecs-staging", "DestinationSecurityGroupId": "sg-test-cache-staging", "FromPort": 6379},
+		{"GroupId": "sg-test-ecs-staging", "FromPort": 2049},
+		{"GroupId": assertions.Match_AnyValue(), "DestinationSecurityGroupId": "sg-test-rds-staging", "FromPort": 3306},
+		{"GroupId": assertions.Match_AnyValue(), "DestinationSecurityGroupId": "sg-test-endpoints-staging", "FromPort": 443},
+	} {
+		storageTemplate.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroupEgress"), egress)
+	}
+	// マスキング・ユーザー作成タスク、認証情報ローテーション用Lambda → RDS / VPCエンドポイントのEgress
+	// （ローテーションのRDSへのポートはクラスターのエンドポイント属性を参照する）
+	for groupName, ports := range map[string]map[string]interface{}{
+		"Service-staging-DataMasking-SG":    {"sg-test-rds-staging": 3306},
+		"Service-staging-DatabaseUser-SG":   {"sg-test-rds-staging": 3306},
+		"Service-staging-SecretRotation-SG": {"sg-test-rds-staging": assertions.Match_AnyValue(), "sg-test-endpoints-staging": 443},
+	} {
+		securityGroups := storageTemplate.FindResources(jsii.String("AWS::EC2::SecurityGroup"), map[string]interface{}{
+			"Properties": map[string]interface{}{"GroupName": groupName},
+		})
+		assert.Len(t, *securityGroups, 1, groupName)
+		for logicalID := range *securityGroups {
+			for destination, port := range ports {
+				storageTemplate.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroupEgress"), map[string]interface{}{
+					"GroupId":                    map[string]interface{}{"Fn::GetAtt": []interface{}{logicalID, "GroupId"}},
+					"DestinationSecurityGroupId": destination,
+					"FromPort":                   port,
+				})
+			}
+		}
+	}
+	rotationApplications := storageTemplate.FindResources(jsii.String("AWS::Serverless::Application"), nil)
+	assert.NotEmpty(t, *rotationApplications)
+	for _, application := range *rotationApplications {
+		properties, err := json.Marshal((*application)["Properties"])
+		assert.NoError(t, err)
+		assert.Contains(t, string(properties), "SecretRotationSecurityGroup")
+	}
+	assertCachedImage(t, storageTemplate, "data-masking", "/service-staging-ecr-public/docker/library/php:8.3-cli")
+	assertCachedImage(t, storageTemplate, "database-user", "/service-staging-ecr-public/docker/library/mysql:8.0")
+
+	// 全送信を許可するEgressはどのStackにも作成しない
+	for _, template := range []assertions.Template{networkTemplate, storageTemplate, applicationTemplate} {
+		assert.Empty(t, *template.FindResources(jsii.String("AWS::EC2::SecurityGroupEgress"), map[string]interface{}{
+			"Properties": map[string]interface{}{"CidrIp": "0.0.0.0/0"},
+		}))
+	}
+}
+
+// TestSecurityMatrixFileIntegration セキュリティグループ定義ファイルのティア・Egress制限が全Stackに反映されることのテスト
+func TestSecurityMatrixFileIntegration(t *testing.T) {
+	// Given: Workerティアを追加し、Egress制限を有効にしたセキュリティグループ定義
+	app := helpers.CreateTestApp(&helpers.TestAppConfig{
+		Environment: "staging",
+		Region:      "ap-northeast-1",
+		Account:     "123456789012",
+	})
+	envConfig, err := config.GetEnvironmentConfig("staging")
+	assert.NoError(t, err)
+	matrix := config.BuildSecurityMatrixConfig("staging", config.SecurityMatrixOptions{
+		ALBExposure:    envConfig.ALBExposure,
+		AllowedCIDRs:   envConfig.RestrictedCIDRs,
+		RestrictEgress: true,
+	})
+	matrix.Tiers = append(matrix.Tiers, config.SecurityTierConfig{
+		Name:        "Worker",
+		Description: "Security group for background workers",
+		Component:   "Worker",
+	})
+	matrix.Rules = append(matrix.Rules, config.SecurityRuleConfig{
+		Tier: "Endpoints", PeerType: config.PeerTypeTier, Peer: "Worker", Protocol: "tcp",
+		FromPort: 443, ToPort: 443, Description: "Allow HTTPS traffic from Worker",
+	})
+
+	// When: RestrictEgressは指定せず、定義のみを全Stackに渡す
+	var networkStack, storageStack, applicationStack awscdk.Stack
+	assert.NotPanics(t, func() {
+		networkStack = stacks.NewNetworkStack(app, "MatrixNetworkStack", &stacks.NetworkStackProps{
+			StackProps: awscdk.StackProps{
+				Env: &awscdk.Environment{
+					Account: jsii.String("123456789012"),
+					Region:  jsii.String("ap-northeast-1"),
+				},
+			},
+			Environment:    "staging",
+			SecurityMatrix: matrix,
+		})
+		storageStack = stacks.NewStorageStack(app, "MatrixStorageStack", &stacks.StorageStackProps{
+			Environment:    "staging",
+			VpcId:          "vpc-from-network-stack",
+			TestEnvFlag:    true,
+			SecurityMatrix: matrix,
+		})
+		applicationStack = stacks.NewApplicationStack(app, "MatrixApplicationStack", &stacks.ApplicationStackProps{
+			Environment:    "staging",
+			VpcId:          "vpc-from-network-stack",
+			TestEnvFlag:    true,
+			SecurityMatrix: matrix,
+		})
+	})
+
+	// Then: NetworkStackは定義ファイルで追加したティアもExport
+	networkTemplate := assertions.Template_FromStack(networkStack, nil)
+	networkTemplate.HasOutput(jsii.String("WorkerSecurityGroupId"), map[string]interface{}{
+		"Export": map[string]interface{}{
+			"Name": "Service-staging-Worker-SG-Id",
+		},
+	})
+	networkTemplate.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroup"), map[string]interface{}{
+		"GroupDescription":    "Security group for ECS tasks",
+		"SecurityGroupEgress": assertions.Match_Absent(),
+	})
+
+	// StorageStack: 定義のrestrictEgressに従いECS側のEgressを作成
+	storageTemplate := assertions.Template_FromStack(storageStack, nil)
+	for _, egress := range []map[string]interface{}{
+		{"GroupId": "sg-test-ecs-staging", "DestinationSecurityGroupId": "sg-test-rds-staging", "FromPort": 3306},
+		{"GroupId": "sg-test-ecs-staging", "DestinationSecurityGroupId": "sg-test-cache-staging", "FromPort": 6379},
+	} {
+		storageTemplate.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroupEgress"), egress)
+	}
+
+	// 全送信を許可するEgressはどのStackにも作成しない
+	applicationTemplate := assertions.Template_FromStack(applicationStack, nil)
+	for _, template := range []assertions.Template{networkTemplate, storageTemplate, applicationTemplate} {
+		assert.Empty(t, *template.FindResources(jsii.String("AWS::EC2::SecurityGroupEgress"), map[string]interface{}{
+			"Properties": map[string]interface{}{"CidrIp": "0.0.0.0/0"},
+		}))
+	}
+}
+
+// assertCachedImage コンテナイメージがプルスルーキャッシュのリポジトリを参照していることを確認
+func assertCachedImage(t *testing.T, template assertions.Template, containerName string, repositorySuffix string) {
+	taskDefinitions := template.FindResources(jsii.String("AWS::ECS::TaskDefinition"), nil)
+	for _, taskDefinition := range *taskDefinitions {
+		for _, container := range (*taskDefinition)["Properties"].(map[string]interface{})["ContainerDefinitions"].([]interface{}) {
+			definition := container.(map[string]interface{})
+			if definition["Name"] != containerName {
+				continue
+			}
+			image, err := json.Marshal(definition["Image"])
+			assert.NoError(t, err)
+			assert.Contains(t, string(image), repositorySuffix)
+			assert.NotContains(t, string(image), "public.ecr.aws")
+			return
+		}
+	}
+	t.Errorf("container %s not found", containerName)
+}
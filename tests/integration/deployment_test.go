@@ -91,7 +91,7 @@ func TestStackResourceCounts(t *testing.T) {
 		description   string
 	}{
 		{"network", "AWS::EC2::VPC", 1, "NetworkStack should have 1 VPC"},
-		{"network", "AWS::EC2::SecurityGroup", 3, "NetworkStack should have 3 Security Groups"},
+		{"network", "AWS::EC2::SecurityGroup", 4, "NetworkStack should have 4 Security Groups"},
 		{"network", "AWS::EC2::InternetGateway", 1, "NetworkStack should have 1 Internet Gateway"},
 		{"storage", "AWS::RDS::DBCluster", 1, "StorageStack should have 1 Aurora cluster"},
 		{"storage", "AWS::ElastiCache::ReplicationGroup", 1, "StorageStack should have 1 Redis cluster"},
@@ -131,6 +131,7 @@ func TestCrossStackOutputs(t *testing.T) {
 		{"ALBSecurityGroupId", "Service-dev-ALB-SG-Id", "ALB Security Group ID"},
 		{"ECSSecurityGroupId", "Service-dev-ECS-SG-Id", "ECS Security Group ID"},
 		{"RDSSecurityGroupId", "Service-dev-RDS-SG-Id", "RDS Security Group ID"},
+		{"CacheSecurityGroupId", "Service-dev-Cache-SG-Id", "Cache Security Group ID"},
 	}
 
 	networkTemplate := assertions.Template_FromStack(allStacks["network"], nil)
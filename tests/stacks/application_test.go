@@ -7,6 +7,7 @@ import (
 	"github.com/aws/jsii-runtime-go"
 	"github.com/stretchr/testify/assert"
 
+	"aws-ecs-fargate-go-cdk/internal/config"
 	"aws-ecs-fargate-go-cdk/internal/stacks"
 	"aws-ecs-fargate-go-cdk/tests/helpers"
 )
@@ -262,6 +263,433 @@ func TestApplicationStack_EnvironmentSpecificSettings(t *testing.T) {
 	}
 }
 
+// TestApplicationStack_TargetGroupPorts Target Groupとポートマッピングの整合性テスト
+func TestApplicationStack_TargetGroupPorts(t *testing.T) {
+	// Given
+	app := helpers.CreateTestApp(&helpers.TestAppConfig{
+		Environment: "dev",
+	})
+
+	// When: ApplicationStackを作成
+	stack := stacks.NewApplicationStack(app, "TestApplicationStack", &stacks.ApplicationStackProps{
+		Environment: "dev",
+		VpcId:       "vpc-12345",
+		TestEnvFlag: true,
+	})
+
+	// Then: Target Groupのポート・ヘルスチェックポートがポートマッピングと一致
+	template := assertions.Template_FromStack(stack, nil)
+	template.HasResourceProperties(jsii.String("AWS::ElasticLoadBalancingV2::TargetGroup"), map[string]interface{}{
+		"Port":            80,
+		"HealthCheckPort": "80",
+		"TargetType":      "ip",
+	})
+
+	assert.NotNil(t, stack)
+}
+
+// TestApplicationStack_HTTPSListener 証明書指定時にHTTPSリスナーを作成しHTTPをリダイレクトすることのテスト
+func TestApplicationStack_HTTPSListener(t *testing.T) {
+	// Given
+	app := helpers.CreateTestApp(&helpers.TestAppConfig{
+		Environment: "prod",
+	})
+	certificate := "arn:aws:acm:ap-northeast-1:123456789012:certificate/11111111-2222-3333-4444-555555555555"
+
+	// When: ApplicationStackを作成
+	stack := stacks.NewApplicationStack(app, "TestApplicationStack", &stacks.ApplicationStackProps{
+		Environment:    "prod",
+		VpcId:          "vpc-12345",
+		TestEnvFlag:    true,
+		ALBCertificate: certificate,
+	})
+
+	// Then: HTTPSリスナーがTarget Groupへ転送
+	template := assertions.Template_FromStack(stack, nil)
+	template.ResourceCountIs(jsii.String("AWS::ElasticLoadBalancingV2::Listener"), jsii.Number(2))
+	template.HasResourceProperties(jsii.String("AWS::ElasticLoadBalancingV2::Listener"), map[string]interface{}{
+		"Port":         443,
+		"Protocol":     "HTTPS",
+		"Certificates": []interface{}{map[string]interface{}{"CertificateArn": certificate}},
+		"DefaultActions": []interface{}{
+			assertions.Match_ObjectLike(&map[string]interface{}{"Type": "forward"}),
+		},
+	})
+
+	// HTTPはHTTPSへ恒久リダイレクト
+	template.HasResourceProperties(jsii.String("AWS::ElasticLoadBalancingV2::Listener"), map[string]interface{}{
+		"Port":     80,
+		"Protocol": "HTTP",
+		"DefaultActions": []interface{}{
+			map[string]interface{}{
+				"Type": "redirect",
+				"RedirectConfig": map[string]interface{}{
+					"Protocol":   "HTTPS",
+					"Port":       "443",
+					"StatusCode": "HTTP_301",
+				},
+			},
+		},
+	})
+
+	// リスナー・ターゲット登録からセキュリティグループへのIngressは追加しない
+	template.ResourceCountIs(jsii.String("AWS::EC2::SecurityGroupIngress"), jsii.Number(0))
+
+	assert.NotNil(t, stack)
+}
+
+// TestApplicationStack_SecurityGroupConnections ALB → ECSの許可をApplicationStackで重複して作成しないことのテスト
+func TestApplicationStack_SecurityGroupConnections(t *testing.T) {
+	// Given
+	app := helpers.CreateTestApp(&helpers.TestAppConfig{
+		Environment: "prod",
+	})
+
+	// When: ApplicationStackを作成
+	stack := stacks.NewApplicationStack(app, "TestApplicationStack", &stacks.ApplicationStackProps{
+		Environment: "prod",
+		VpcId:       "vpc-12345",
+		TestEnvFlag: true,
+	})
+
+	// Then: ティア間の許可はNetworkStackのセキュリティグループ定義で作成するため、ルールを追加しない
+	template := assertions.Template_FromStack(stack, nil)
+	template.ResourceCountIs(jsii.String("AWS::EC2::SecurityGroupIngress"), jsii.Number(0))
+	template.ResourceCountIs(jsii.String("AWS::EC2::SecurityGroupEgress"), jsii.Number(0))
+
+	// ALB → ECSのルールはセキュリティグループ定義にコンテナポートから生成される
+	matrix := config.GetSecurityMatrixConfig("prod")
+	assert.Contains(t, matrix.Rules, config.SecurityRuleConfig{
+		Tier: "ECS", PeerType: config.PeerTypeTier, Peer: "ALB", Protocol: "tcp",
+		FromPort: 80, ToPort: 80, Description: "Allow container port 80 from ALB",
+	})
+
+	assert.NotNil(t, stack)
+}
+
+// TestApplicationStack_DatabaseConnection DB接続用の環境変数がAuroraのエンジンに追従することのテスト
+func TestApplicationStack_DatabaseConnection(t *testing.T) {
+	testCases := []struct {
+		name               string
+		engine             string
+		expectedConnection string
+		expectedPort       string
+	}{
+		{name: "Aurora MySQL (default)", engine: "", expectedConnection: "mysql", expectedPort: "3306"},
+		{name: "Aurora PostgreSQL", engine: config.DatabaseEngineAuroraPostgreSQL, expectedConnection: "pgsql", expectedPort: "5432"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			app := helpers.CreateTestApp(&helpers.TestAppConfig{
+				Environment: "staging",
+			})
+
+			// When
+			stack := stacks.NewApplicationStack(app, "TestApplicationStack", &stacks.ApplicationStackProps{
+				Environment:    "staging",
+				VpcId:          "vpc-12345",
+				TestEnvFlag:    true,
+				DatabaseEngine: tc.engine,
+			})
+
+			// Then: DB_CONNECTION・DB_PORTがエンジンに一致
+			template := assertions.Template_FromStack(stack, nil)
+			template.HasResourceProperties(jsii.String("AWS::ECS::TaskDefinition"), map[string]interface{}{
+				"ContainerDefinitions": assertions.Match_ArrayWith(&[]interface{}{
+					assertions.Match_ObjectLike(&map[string]interface{}{
+						"Name": "php-app",
+						"Environment": assertions.Match_ArrayWith(&[]interface{}{
+							map[string]interface{}{"Name": "DB_CONNECTION", "Value": tc.expectedConnection},
+							map[string]interface{}{"Name": "DB_PORT", "Value": tc.expectedPort},
+						}),
+					}),
+				}),
+			})
+
+			assert.NotNil(t, stack)
+		})
+	}
+}
+
+// TestApplicationStack_DatabaseSecret StorageStackのDB認証情報を参照することのテスト
+func TestApplicationStack_DatabaseSecret(t *testing.T) {
+	// Given
+	secretArn := "arn:aws:secretsmanager:ap-northeast-1:123456789012:secret:service-staging-db-credentials-AbCdEf"
+	app := helpers.CreateTestApp(&helpers.TestAppConfig{
+		Environment: "staging",
+	})
+
+	// When: StorageStackのシークレットARNを指定
+	stack := stacks.NewApplicationStack(app, "TestApplicationStack", &stacks.ApplicationStackProps{
+		Environment:       "staging",
+		VpcId:             "vpc-12345",
+		TestEnvFlag:       true,
+		DatabaseSecretArn: secretArn,
+	})
+
+	// Then: ApplicationStackではシークレットを作成しない
+	template := assertions.Template_FromStack(stack, nil)
+	template.ResourceCountIs(jsii.String("AWS::SecretsManager::Secret"), jsii.Number(0))
+
+	// コンテナには共有シークレットのusername/passwordを注入
+	template.HasResourceProperties(jsii.String("AWS::ECS::TaskDefinition"), map[string]interface{}{
+		"ContainerDefinitions": assertions.Match_ArrayWith(&[]interface{}{
+			assertions.Match_ObjectLike(&map[string]interface{}{
+				"Name": "php-app",
+				"Secrets": assertions.Match_ArrayWith(&[]interface{}{
+					map[string]interface{}{"Name": "DB_PASSWORD", "ValueFrom": secretArn + ":password::"},
+					map[string]interface{}{"Name": "DB_USERNAME", "ValueFrom": secretArn + ":username::"},
+				}),
+			}),
+		}),
+	})
+
+	// Execution Roleの読み取り権限はこのシークレットのみ
+	template.HasResourceProperties(jsii.String("AWS::IAM::Policy"), map[string]interface{}{
+		"PolicyDocument": map[string]interface{}{
+			"Statement": assertions.Match_ArrayWith(&[]interface{}{
+				assertions.Match_ObjectLike(&map[string]interface{}{
+					"Action":   []interface{}{"secretsmanager:GetSecretValue", "secretsmanager:DescribeSecret"},
+					"Resource": secretArn,
+				}),
+			}),
+		},
+		"Roles": []interface{}{
+			map[string]interface{}{"Ref": assertions.Match_StringLikeRegexp(jsii.String("ECSExecutionRole"))},
+		},
+	})
+
+	// Task Roleにはシークレットへのワイルドカード権限を付与しない
+	template.AllResourcesProperties(jsii.String("AWS::IAM::Policy"), map[string]interface{}{
+		"PolicyDocument": map[string]interface{}{
+			"Statement": assertions.Match_Not(assertions.Match_ArrayWith(&[]interface{}{
+				assertions.Match_ObjectLike(&map[string]interface{}{
+					"Action":   assertions.Match_ArrayWith(&[]interface{}{"secretsmanager:GetSecretValue"}),
+					"Resource": "*",
+				}),
+			})),
+		},
+	})
+
+	assert.NotNil(t, stack)
+}
+
+// TestApplicationStack_RedisSecret StorageStackのRedis認証情報を参照することのテスト
+func TestApplicationStack_RedisSecret(t *testing.T) {
+	// Given
+	secretArn := "arn:aws:secretsmanager:ap-northeast-1:123456789012:secret:service-production-redis-credentials-GhIjKl"
+	app := helpers.CreateTestApp(&helpers.TestAppConfig{
+		Environment: "prod",
+	})
+
+	// When: StorageStackのシークレットARNを指定
+	stack := stacks.NewApplicationStack(app, "TestApplicationStack", &stacks.ApplicationStackProps{
+		Environment:    "prod",
+		VpcId:          "vpc-12345",
+		TestEnvFlag:    true,
+		RedisSecretArn: secretArn,
+	})
+
+	// Then: PHPコンテナにRedisのusername/passwordを注入（認証方式によらず同じキー）
+	template := assertions.Template_FromStack(stack, nil)
+	template.HasResourceProperties(jsii.String("AWS::ECS::TaskDefinition"), map[string]interface{}{
+		"ContainerDefinitions": assertions.Match_ArrayWith(&[]interface{}{
+			assertions.Match_ObjectLike(&map[string]interface{}{
+				"Name": "php-app",
+				"Secrets": assertions.Match_ArrayWith(&[]interface{}{
+					map[string]interface{}{"Name": "REDIS_PASSWORD", "ValueFrom": secretArn + ":password::"},
+					map[string]interface{}{"Name": "REDIS_USERNAME", "ValueFrom": secretArn + ":username::"},
+				}),
+			}),
+		}),
+	})
+
+	// Execution Roleにこのシークレットの読み取り権限を付与
+	template.HasResourceProperties(jsii.String("AWS::IAM::Policy"), map[string]interface{}{
+		"PolicyDocument": map[string]interface{}{
+			"Statement": assertions.Match_ArrayWith(&[]interface{}{
+				assertions.Match_ObjectLike(&map[string]interface{}{
+					"Action":   []interface{}{"secretsmanager:GetSecretValue", "secretsmanager:DescribeSecret"},
+					"Resource": secretArn,
+				}),
+			}),
+		},
+	})
+
+	assert.NotNil(t, stack)
+}
+
+// TestApplicationStack_ALBAccessLogs ALBのアクセスログ・接続ログをログ用バケットに出力することのテスト
+func TestApplicationStack_ALBAccessLogs(t *testing.T) {
+	testCases := []struct {
+		name           string
+		logsBucketName string
+		expectedBucket string
+	}{
+		{name: "Default Logs Bucket", logsBucketName: "", expectedBucket: "service-development-logs"},
+		{name: "Explicit Logs Bucket", logsBucketName: "service-shared-logs", expectedBucket: "service-shared-logs"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			app := helpers.CreateTestApp(&helpers.TestAppConfig{
+				Environment: "dev",
+			})
+
+			// When
+			stack := stacks.NewApplicationStack(app, "TestApplicationStack", &stacks.ApplicationStackProps{
+				Environment:    "dev",
+				VpcId:          "vpc-12345",
+				TestEnvFlag:    true,
+				LogsBucketName: tc.logsBucketName,
+			})
+
+			// Then: プレフィックスを分けてアクセスログ・接続ログを出力
+			template := assertions.Template_FromStack(stack, nil)
+			template.HasResourceProperties(jsii.String("AWS::ElasticLoadBalancingV2::LoadBalancer"), map[string]interface{}{
+				"LoadBalancerAttributes": assertions.Match_ArrayWith(&[]interface{}{
+					map[string]interface{}{"Key": "access_logs.s3.enabled", "Value": "true"},
+					map[string]interface{}{"Key": "access_logs.s3.bucket", "Value": tc.expectedBucket},
+					map[string]interface{}{"Key": "access_logs.s3.prefix", "Value": "alb/access"},
+					map[string]interface{}{"Key": "connection_logs.s3.enabled", "Value": "true"},
+					map[string]interface{}{"Key": "connection_logs.s3.bucket", "Value": tc.expectedBucket},
+					map[string]interface{}{"Key": "connection_logs.s3.prefix", "Value": "alb/connection"},
+				}),
+			})
+		})
+	}
+}
+
+// TestApplicationStack_StaticAssetsUrl CloudFrontの静的アセットURLをASSET_URLとして注入することのテスト
+func TestApplicationStack_StaticAssetsUrl(t *testing.T) {
+	testCases := []struct {
+		name            string
+		staticAssetsUrl string
+		expectedUrl     string
+	}{
+		{name: "Default Distribution", staticAssetsUrl: "", expectedUrl: "https://d111111abcdef8.cloudfront.net"},
+		{name: "Custom Domain", staticAssetsUrl: "https://assets.example.com", expectedUrl: "https://assets.example.com"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			app := helpers.CreateTestApp(&helpers.TestAppConfig{
+				Environment: "staging",
+			})
+
+			// When
+			stack := stacks.NewApplicationStack(app, "TestApplicationStack", &stacks.ApplicationStackProps{
+				Environment:     "staging",
+				VpcId:           "vpc-12345",
+				TestEnvFlag:     true,
+				StaticAssetsUrl: tc.staticAssetsUrl,
+			})
+
+			// Then
+			template := assertions.Template_FromStack(stack, nil)
+			template.HasResourceProperties(jsii.String("AWS::ECS::TaskDefinition"), map[string]interface{}{
+				"ContainerDefinitions": assertions.Match_ArrayWith(&[]interface{}{
+					assertions.Match_ObjectLike(&map[string]interface{}{
+						"Name": "php-app",
+						"Environment": assertions.Match_ArrayWith(&[]interface{}{
+							map[string]interface{}{"Name": "ASSET_URL", "Value": tc.expectedUrl},
+						}),
+					}),
+				}),
+			})
+		})
+	}
+}
+
+// TestApplicationStack_DatabaseProxy RDS Proxy有効時にProxyのエンドポイントへ接続することのテスト
+func TestApplicationStack_DatabaseProxy(t *testing.T) {
+	testCases := []struct {
+		name         string
+		environment  string
+		proxy        *config.DatabaseProxyConfig
+		expectedHost string
+		expectIAM    bool
+	}{
+		{name: "Development - Cluster Endpoint", environment: "dev", expectedHost: "mock-aurora-endpoint.cluster-xyz.rds.amazonaws.com"},
+		{name: "Staging - Proxy Endpoint", environment: "staging", expectedHost: "service-staging-db-proxy.proxy-xyz.ap-northeast-1.rds.amazonaws.com"},
+		{
+			name:        "Production - Proxy IAM Authentication",
+			environment: "prod",
+			proxy: func() *config.DatabaseProxyConfig {
+				c := config.GetDatabaseProxyConfig("prod")
+				c.IAMAuth = true
+				return &c
+			}(),
+			expectedHost: "service-production-db-proxy.proxy-xyz.ap-northeast-1.rds.amazonaws.com",
+			expectIAM:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			app := helpers.CreateTestApp(&helpers.TestAppConfig{
+				Environment: tc.environment,
+			})
+
+			// When
+			stack := stacks.NewApplicationStack(app, "TestApplicationStack", &stacks.ApplicationStackProps{
+				Environment:   tc.environment,
+				VpcId:         "vpc-12345",
+				TestEnvFlag:   true,
+				DatabaseProxy: tc.proxy,
+			})
+
+			// Then: DB_HOSTはProxy有効時のみProxyのエンドポイント
+			template := assertions.Template_FromStack(stack, nil)
+			template.HasResourceProperties(jsii.String("AWS::ECS::TaskDefinition"), map[string]interface{}{
+				"ContainerDefinitions": assertions.Match_ArrayWith(&[]interface{}{
+					assertions.Match_ObjectLike(&map[string]interface{}{
+						"Name": "php-app",
+						"Environment": assertions.Match_ArrayWith(&[]interface{}{
+							map[string]interface{}{"Name": "DB_HOST", "Value": tc.expectedHost},
+						}),
+					}),
+				}),
+			})
+
+			// IAM認証時のみTask Roleにrds-db:connectを付与
+			connect := template.FindResources(jsii.String("AWS::IAM::Policy"), &map[string]interface{}{
+				"Properties": map[string]interface{}{
+					"PolicyDocument": map[string]interface{}{
+						"Statement": assertions.Match_ArrayWith(&[]interface{}{
+							assertions.Match_ObjectLike(&map[string]interface{}{"Action": "rds-db:connect"}),
+						}),
+					},
+					"Roles": []interface{}{
+						map[string]interface{}{"Ref": assertions.Match_StringLikeRegexp(jsii.String("ECSTaskRole"))},
+					},
+				},
+			})
+			if tc.expectIAM {
+				assert.Len(t, *connect, 1)
+				template.HasResourceProperties(jsii.String("AWS::ECS::TaskDefinition"), map[string]interface{}{
+					"ContainerDefinitions": assertions.Match_ArrayWith(&[]interface{}{
+						assertions.Match_ObjectLike(&map[string]interface{}{
+							"Environment": assertions.Match_ArrayWith(&[]interface{}{
+								map[string]interface{}{"Name": "DB_IAM_AUTH", "Value": "true"},
+							}),
+						}),
+					}),
+				})
+			} else {
+				assert.Empty(t, *connect)
+			}
+
+			assert.NotNil(t, stack)
+		})
+	}
+}
+
 // TestApplicationStack_ServiceDiscovery Service Discoveryのテスト
 func TestApplicationStack_ServiceDiscovery(t *testing.T) {
 	// Given
@@ -0,0 +1,240 @@
+package stacks_test
+
+import (
+	"aws-ecs-fargate-go-cdk/internal/config"
+	"aws-ecs-fargate-go-cdk/tests/helpers"
+	"testing"
+
+	"github.com/aws/aws-cdk-go/awscdk/v2/assertions"
+	"github.com/aws/jsii-runtime-go"
+	"github.com/stretchr/testify/assert"
+
+	"aws-ecs-fargate-go-cdk/internal/stacks"
+)
+
+func TestBackupStack_Production(t *testing.T) {
+	// Given
+	app := helpers.CreateTestAppForUnitTest("prod")
+
+	// When
+	stack := stacks.NewBackupStack(app, "TestBackupStack", &stacks.BackupStackProps{
+		Environment: "prod",
+	})
+
+	// Then: KeyStackのバックアップ用キーで暗号化したロック付きボールト
+	template := assertions.Template_FromStack(stack, nil)
+	template.ResourceCountIs(jsii.String("AWS::KMS::Key"), jsii.Number(0))
+	template.HasResource(jsii.String("AWS::Backup::BackupVault"), map[string]interface{}{
+		"DeletionPolicy": "Retain",
+		"Properties": assertions.Match_ObjectLike(&map[string]interface{}{
+			"BackupVaultName":  "service-production-backup-vault",
+			"EncryptionKeyArn": map[string]interface{}{"Fn::ImportValue": "Service-prod-backups-Key-Arn"},
+			"LockConfiguration": map[string]interface{}{
+				"MinRetentionDays":  7,
+				"MaxRetentionDays":  3650,
+				"ChangeableForDays": 3,
+			},
+		}),
+	})
+
+	// 日次・週次・月次のルール（月次はコールドストレージへ移行し、大阪リージョンにコピー）
+	template.HasResourceProperties(jsii.String("AWS::Backup::BackupPlan"), map[string]interface{}{
+		"BackupPlan": assertions.Match_ObjectLike(&map[string]interface{}{
+			"BackupPlanName": "service-production-backup-plan",
+			"BackupPlanRule": []interface{}{
+				assertions.Match_ObjectLike(&map[string]interface{}{
+					"RuleName":           "daily",
+					"ScheduleExpression": "cron(0 18 * * ? *)",
+					"Lifecycle":          map[string]interface{}{"DeleteAfterDays": 35},
+				}),
+				assertions.Match_ObjectLike(&map[string]interface{}{
+					"RuleName":  "weekly",
+					"Lifecycle": map[string]interface{}{"DeleteAfterDays": 90},
+				}),
+				assertions.Match_ObjectLike(&map[string]interface{}{
+					"RuleName": "monthly",
+					"Lifecycle": map[string]interface{}{
+						"DeleteAfterDays":            365,
+						"MoveToColdStorageAfterDays": 30,
+					},
+					"CopyActions": []interface{}{
+						map[string]interface{}{
+							"DestinationBackupVaultArn": map[string]interface{}{
+								"Fn::Sub": "arn:${AWS::Partition}:backup:ap-northeast-3:${AWS::AccountId}:backup-vault:service-production-backup-vault",
+							},
+							"Lifecycle": map[string]interface{}{"DeleteAfterDays": 365},
+						},
+					},
+				}),
+			},
+		}),
+	})
+
+	// Backup: Requiredタグでリソースを選択
+	template.HasResourceProperties(jsii.String("AWS::Backup::BackupSelection"), map[string]interface{}{
+		"BackupSelection": assertions.Match_ObjectLike(&map[string]interface{}{
+			"SelectionName": "service-production-tagged-resources",
+			"ListOfTags": []interface{}{
+				map[string]interface{}{
+					"ConditionKey":   "Backup",
+					"ConditionType":  "STRINGEQUALS",
+					"ConditionValue": "Required",
+				},
+			},
+		}),
+	})
+
+	// S3のバックアップ・復元用のポリシー
+	template.HasResourceProperties(jsii.String("AWS::IAM::Role"), map[string]interface{}{
+		"RoleName": "service-production-backup",
+		"ManagedPolicyArns": assertions.Match_ArrayWith(&[]interface{}{
+			map[string]interface{}{
+				"Fn::Join": []interface{}{"", []interface{}{"arn:", map[string]interface{}{"Ref": "AWS::Partition"}, ":iam::aws:policy/AWSBackupServiceRolePolicyForS3Backup"}},
+			},
+		}),
+	})
+
+	helpers.AssertStackHasOutput(t, stack, "BackupVaultArn", "service-production-Backup-Vault-Arn")
+	helpers.AssertStackHasOutput(t, stack, "BackupPlanId", "service-production-Backup-Plan-Id")
+}
+
+func TestBackupStack_Staging(t *testing.T) {
+	// Given
+	app := helpers.CreateTestAppForUnitTest("staging")
+
+	// When
+	stack := stacks.NewBackupStack(app, "TestBackupStack", &stacks.BackupStackProps{
+		Environment: "staging",
+	})
+
+	// Then: ロックなしのボールトと日次・週次のルール（コピーなし）
+	template := assertions.Template_FromStack(stack, nil)
+	template.HasResourceProperties(jsii.String("AWS::Backup::BackupVault"), map[string]interface{}{
+		"BackupVaultName":   "service-staging-backup-vault",
+		"LockConfiguration": assertions.Match_Absent(),
+	})
+	template.HasResourceProperties(jsii.String("AWS::Backup::BackupPlan"), map[string]interface{}{
+		"BackupPlan": assertions.Match_ObjectLike(&map[string]interface{}{
+			"BackupPlanRule": []interface{}{
+				assertions.Match_ObjectLike(&map[string]interface{}{
+					"RuleName":    "daily",
+					"Lifecycle":   map[string]interface{}{"DeleteAfterDays": 7},
+					"CopyActions": assertions.Match_Absent(),
+				}),
+				assertions.Match_ObjectLike(&map[string]interface{}{
+					"RuleName":    "weekly",
+					"Lifecycle":   map[string]interface{}{"DeleteAfterDays": 35},
+					"CopyActions": assertions.Match_Absent(),
+				}),
+			},
+		}),
+	})
+}
+
+func TestBackupStack_CopyDestination(t *testing.T) {
+	// Given
+	app := helpers.CreateTestAppForUnitTest("prod")
+
+	// When: コピー先リージョンのボールトのみを作成
+	stack := stacks.NewBackupStack(app, "TestBackupStackCopy", &stacks.BackupStackProps{
+		Environment:     "prod",
+		CopyDestination: true,
+	})
+
+	// Then: コピー元と同名・同じロック設定のボールトを作成し、プランは作成しない
+	template := assertions.Template_FromStack(stack, nil)
+	template.HasResourceProperties(jsii.String("AWS::Backup::BackupVault"), map[string]interface{}{
+		"BackupVaultName": "service-production-backup-vault",
+		"LockConfiguration": assertions.Match_ObjectLike(&map[string]interface{}{
+			"MinRetentionDays": 7,
+		}),
+	})
+	template.ResourceCountIs(jsii.String("AWS::Backup::BackupPlan"), jsii.Number(0))
+	template.ResourceCountIs(jsii.String("AWS::Backup::BackupSelection"), jsii.Number(0))
+}
+
+func TestBackupStack_InvalidConfiguration(t *testing.T) {
+	testCases := []struct {
+		name   string
+		modify func(c *config.BackupConfig)
+	}{
+		{
+			name:   "Disabled",
+			modify: func(c *config.BackupConfig) { c.Enabled = false },
+		},
+		{
+			name:   "No Rules",
+			modify: func(c *config.BackupConfig) { c.Rules = nil },
+		},
+		{
+			name:   "Duplicate Rule Name",
+			modify: func(c *config.BackupConfig) { c.Rules[1].Name = c.Rules[0].Name },
+		},
+		{
+			name:   "Rate Schedule",
+			modify: func(c *config.BackupConfig) { c.Rules[0].Schedule = "rate(1 day)" },
+		},
+		{
+			name: "Cold Storage Minimum Retention",
+			modify: func(c *config.BackupConfig) {
+				c.Rules[2].MoveToColdStorageAfterDays = 300
+			},
+		},
+		{
+			name:   "Copy Without Region",
+			modify: func(c *config.BackupConfig) { c.CopyRegion = "" },
+		},
+		{
+			name:   "Retention Below Vault Lock",
+			modify: func(c *config.BackupConfig) { c.Rules[0].DeleteAfterDays = 3 },
+		},
+		{
+			name:   "Vault Lock Changeable Days",
+			modify: func(c *config.BackupConfig) { c.VaultLock.ChangeableForDays = 1 },
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			app := helpers.CreateTestAppForUnitTest("prod")
+			backupConfig := config.GetBackupConfig("prod")
+			tc.modify(&backupConfig)
+
+			// When/Then
+			assert.Panics(t, func() {
+				stacks.NewBackupStack(app, "TestBackupStack", &stacks.BackupStackProps{
+					Environment: "prod",
+					Backup:      &backupConfig,
+				})
+			})
+		})
+	}
+}
+
+func TestStorageStack_BackupSelectionTags(t *testing.T) {
+	// Given
+	app := CreateTestAppForStorageStack("prod")
+
+	// When
+	stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+		Environment: "prod",
+		VpcId:       "vpc-12345",
+		TestEnvFlag: true,
+	})
+
+	// Then: バージョニング無効のログ用バケットはBackupタグを上書きして対象外
+	template := assertions.Template_FromStack(stack, nil)
+	template.HasResourceProperties(jsii.String("AWS::S3::Bucket"), map[string]interface{}{
+		"BucketName": "service-production-logs",
+		"Tags": assertions.Match_ArrayWith(&[]interface{}{
+			map[string]interface{}{"Key": "Backup", "Value": "NotRequired"},
+		}),
+	})
+	template.HasResourceProperties(jsii.String("AWS::S3::Bucket"), map[string]interface{}{
+		"BucketName": "service-production-static-assets",
+		"Tags": assertions.Match_ArrayWith(&[]interface{}{
+			map[string]interface{}{"Key": "Backup", "Value": "Required"},
+		}),
+	})
+}
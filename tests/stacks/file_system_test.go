@@ -0,0 +1,266 @@
+package stacks_test
+
+import (
+	"aws-ecs-fargate-go-cdk/internal/config"
+	"aws-ecs-fargate-go-cdk/tests/helpers"
+	"testing"
+
+	"github.com/aws/aws-cdk-go/awscdk/v2/assertions"
+	"github.com/aws/jsii-runtime-go"
+	"github.com/stretchr/testify/assert"
+
+	"aws-ecs-fargate-go-cdk/internal/stacks"
+)
+
+func TestStorageStack_FileSystem(t *testing.T) {
+	// Given
+	app := helpers.CreateTestAppForUnitTest("prod")
+
+	// When
+	stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+		Environment: "prod",
+		VpcId:       "vpc-12345",
+		TestEnvFlag: true,
+	})
+
+	// Then: ファイルのCMKで暗号化したEFS
+	template := assertions.Template_FromStack(stack, nil)
+	template.HasResource(jsii.String("AWS::EFS::FileSystem"), map[string]interface{}{
+		"DeletionPolicy": "Retain",
+		"Properties": assertions.Match_ObjectLike(&map[string]interface{}{
+			"Encrypted":         true,
+			"KmsKeyId":          "arn:aws:kms:ap-northeast-1:123456789012:key/test-files-prod",
+			"PerformanceMode":   "generalPurpose",
+			"ThroughputMode":    "elastic",
+			"LifecyclePolicies": []interface{}{map[string]interface{}{"TransitionToIA": "AFTER_30_DAYS"}},
+		}),
+	})
+
+	// マウントターゲットはECSタスクと同じプライベートサブネットに作成
+	template.ResourceCountIs(jsii.String("AWS::EFS::MountTarget"), jsii.Number(2))
+	template.HasResourceProperties(jsii.String("AWS::EFS::MountTarget"), map[string]interface{}{
+		"SubnetId": "subnet-test-private-1-prod",
+	})
+	template.HasResourceProperties(jsii.String("AWS::EFS::MountTarget"), map[string]interface{}{
+		"SubnetId": "subnet-test-private-2-prod",
+	})
+
+	// ECSティアからのNFSのみ許可
+	template.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroup"), map[string]interface{}{
+		"GroupName": "Service-production-EFS-SG",
+	})
+	template.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroupIngress"), map[string]interface{}{
+		"IpProtocol":            "tcp",
+		"FromPort":              2049,
+		"ToPort":                2049,
+		"SourceSecurityGroupId": "sg-test-ecs-prod",
+		"GroupId": map[string]interface{}{
+			"Fn::GetAtt": []interface{}{assertions.Match_StringLikeRegexp(jsii.String("^FileSystemSecurityGroup")), "GroupId"},
+		},
+	})
+
+	// アプリケーションのパスごとのアクセスポイント
+	template.ResourceCountIs(jsii.String("AWS::EFS::AccessPoint"), jsii.Number(2))
+	template.HasResourceProperties(jsii.String("AWS::EFS::AccessPoint"), map[string]interface{}{
+		"PosixUser": map[string]interface{}{"Uid": "82", "Gid": "82"},
+		"RootDirectory": map[string]interface{}{
+			"Path":         "/uploads",
+			"CreationInfo": map[string]interface{}{"OwnerUid": "82", "OwnerGid": "82", "Permissions": "755"},
+		},
+	})
+	template.HasResourceProperties(jsii.String("AWS::EFS::AccessPoint"), map[string]interface{}{
+		"RootDirectory": assertions.Match_ObjectLike(&map[string]interface{}{
+			"Path": "/sessions",
+		}),
+	})
+
+	// ApplicationStackから参照するExport
+	template.HasOutput(jsii.String("FileSystemId"), map[string]interface{}{
+		"Export": map[string]interface{}{"Name": "service-production-EFS-Id"},
+	})
+	template.HasOutput(jsii.String("UploadsAccessPointId"), map[string]interface{}{
+		"Export": map[string]interface{}{"Name": "service-production-EFS-uploads-Access-Point-Id"},
+	})
+	template.HasOutput(jsii.String("SessionsAccessPointId"), map[string]interface{}{
+		"Export": map[string]interface{}{"Name": "service-production-EFS-sessions-Access-Point-Id"},
+	})
+}
+
+func TestStorageStack_FileSystemDisabledInDevelopment(t *testing.T) {
+	// Given
+	app := helpers.CreateTestAppForUnitTest("dev")
+
+	// When
+	stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+		Environment: "dev",
+		VpcId:       "vpc-12345",
+		TestEnvFlag: true,
+	})
+
+	// Then
+	template := assertions.Template_FromStack(stack, nil)
+	template.ResourceCountIs(jsii.String("AWS::EFS::FileSystem"), jsii.Number(0))
+	template.ResourceCountIs(jsii.String("AWS::EFS::AccessPoint"), jsii.Number(0))
+}
+
+func TestStorageStack_InvalidFileSystemConfiguration(t *testing.T) {
+	validAccessPoint := config.FileSystemAccessPointConfig{
+		Name: "uploads", Path: "/uploads", ContainerPath: "/var/www/html/storage/app/public", UID: 82, GID: 82, Permissions: "755",
+	}
+	fileSystem := func(modify func(c *config.FileSystemConfig)) config.FileSystemConfig {
+		c := config.FileSystemConfig{
+			Enabled:         true,
+			PerformanceMode: config.FileSystemPerformanceModeGeneralPurpose,
+			ThroughputMode:  config.FileSystemThroughputModeBursting,
+			AccessPoints:    []config.FileSystemAccessPointConfig{validAccessPoint},
+		}
+		modify(&c)
+		return c
+	}
+
+	testCases := []struct {
+		name       string
+		fileSystem config.FileSystemConfig
+	}{
+		{
+			name:       "Elastic throughput with Max I/O",
+			fileSystem: fileSystem(func(c *config.FileSystemConfig) { c.PerformanceMode, c.ThroughputMode = "maxIO", "elastic" }),
+		},
+		{
+			name:       "Unsupported transition to IA",
+			fileSystem: fileSystem(func(c *config.FileSystemConfig) { c.TransitionToIADays = 45 }),
+		},
+		{
+			name:       "No access points",
+			fileSystem: fileSystem(func(c *config.FileSystemConfig) { c.AccessPoints = nil }),
+		},
+		{
+			name: "Duplicate access point",
+			fileSystem: fileSystem(func(c *config.FileSystemConfig) {
+				c.AccessPoints = append(c.AccessPoints, validAccessPoint)
+			}),
+		},
+		{
+			name:       "Root path",
+			fileSystem: fileSystem(func(c *config.FileSystemConfig) { c.AccessPoints[0].Path = "/" }),
+		},
+		{
+			name:       "Relative container path",
+			fileSystem: fileSystem(func(c *config.FileSystemConfig) { c.AccessPoints[0].ContainerPath = "storage/app" }),
+		},
+		{
+			name:       "Invalid permissions",
+			fileSystem: fileSystem(func(c *config.FileSystemConfig) { c.AccessPoints[0].Permissions = "rwx" }),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			app := helpers.CreateTestAppForUnitTest("staging")
+			fileSystemConfig := tc.fileSystem
+
+			// When & Then
+			assert.Panics(t, func() {
+				stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+					Environment: "staging",
+					VpcId:       "vpc-12345",
+					TestEnvFlag: true,
+					FileSystem:  &fileSystemConfig,
+				})
+			})
+		})
+	}
+}
+
+func TestApplicationStack_FileSystemVolumes(t *testing.T) {
+	// Given
+	app := helpers.CreateTestAppForUnitTest("staging")
+
+	// When
+	stack := stacks.NewApplicationStack(app, "TestApplicationStack", &stacks.ApplicationStackProps{
+		Environment: "staging",
+		VpcId:       "vpc-12345",
+		TestEnvFlag: true,
+	})
+
+	// Then: アクセスポイントごとに転送時の暗号化・IAM認可を有効にしたボリューム
+	template := assertions.Template_FromStack(stack, nil)
+	template.HasResourceProperties(jsii.String("AWS::ECS::TaskDefinition"), map[string]interface{}{
+		"Volumes": []interface{}{
+			map[string]interface{}{
+				"Name": "efs-uploads",
+				"EFSVolumeConfiguration": map[string]interface{}{
+					"FilesystemId":      "fs-test-staging",
+					"TransitEncryption": "ENABLED",
+					"AuthorizationConfig": map[string]interface{}{
+						"AccessPointId": "fsap-test-uploads-staging",
+						"IAM":           "ENABLED",
+					},
+				},
+			},
+			map[string]interface{}{
+				"Name": "efs-sessions",
+				"EFSVolumeConfiguration": map[string]interface{}{
+					"FilesystemId":      "fs-test-staging",
+					"TransitEncryption": "ENABLED",
+					"AuthorizationConfig": map[string]interface{}{
+						"AccessPointId": "fsap-test-sessions-staging",
+						"IAM":           "ENABLED",
+					},
+				},
+			},
+		},
+		// php-appは読み書き、nginx-webはアップロードファイルのみ読み取り専用
+		"ContainerDefinitions": assertions.Match_ArrayWith(&[]interface{}{
+			assertions.Match_ObjectLike(&map[string]interface{}{
+				"Name": "nginx-web",
+				"MountPoints": []interface{}{
+					map[string]interface{}{"SourceVolume": "efs-uploads", "ContainerPath": "/var/www/html/storage/app/public", "ReadOnly": true},
+				},
+			}),
+			assertions.Match_ObjectLike(&map[string]interface{}{
+				"Name": "php-app",
+				"MountPoints": []interface{}{
+					map[string]interface{}{"SourceVolume": "efs-uploads", "ContainerPath": "/var/www/html/storage/app/public", "ReadOnly": false},
+					map[string]interface{}{"SourceVolume": "efs-sessions", "ContainerPath": "/var/www/html/storage/framework/sessions", "ReadOnly": false},
+				},
+			}),
+		}),
+	})
+
+	// Task Roleにマウントターゲット経由の読み書きを許可
+	template.HasResourceProperties(jsii.String("AWS::IAM::Policy"), map[string]interface{}{
+		"PolicyDocument": map[string]interface{}{
+			"Statement": assertions.Match_ArrayWith(&[]interface{}{
+				assertions.Match_ObjectLike(&map[string]interface{}{
+					"Action": []interface{}{"elasticfilesystem:ClientMount", "elasticfilesystem:ClientWrite"},
+					"Condition": map[string]interface{}{
+						"Bool": map[string]interface{}{"elasticfilesystem:AccessedViaMountTarget": "true"},
+					},
+				}),
+			}),
+		},
+		"Roles": []interface{}{
+			map[string]interface{}{"Ref": assertions.Match_StringLikeRegexp(jsii.String("^ECSTaskRole"))},
+		},
+	})
+}
+
+func TestApplicationStack_NoFileSystemVolumesInDevelopment(t *testing.T) {
+	// Given
+	app := helpers.CreateTestAppForUnitTest("dev")
+
+	// When
+	stack := stacks.NewApplicationStack(app, "TestApplicationStack", &stacks.ApplicationStackProps{
+		Environment: "dev",
+		VpcId:       "vpc-12345",
+		TestEnvFlag: true,
+	})
+
+	// Then
+	template := assertions.Template_FromStack(stack, nil)
+	template.HasResourceProperties(jsii.String("AWS::ECS::TaskDefinition"), map[string]interface{}{
+		"Volumes": assertions.Match_Absent(),
+	})
+}
@@ -0,0 +1,308 @@
+package stacks_test
+
+import (
+	"aws-ecs-fargate-go-cdk/internal/config"
+	"aws-ecs-fargate-go-cdk/tests/helpers"
+	"testing"
+
+	"github.com/aws/aws-cdk-go/awscdk/v2/assertions"
+	"github.com/aws/jsii-runtime-go"
+	"github.com/stretchr/testify/assert"
+
+	"aws-ecs-fargate-go-cdk/internal/stacks"
+)
+
+func TestKeyStack_Production(t *testing.T) {
+	// Given
+	app := helpers.CreateTestAppForUnitTest("prod")
+
+	// When
+	stack := stacks.NewKeyStack(app, "TestKeyStack", &stacks.KeyStackProps{
+		Environment: "prod",
+	})
+
+	// Then: データ分類ごとにローテーション付きのキーを作成
+	template := assertions.Template_FromStack(stack, nil)
+	template.ResourceCountIs(jsii.String("AWS::KMS::Key"), jsii.Number(len(config.DataClasses())))
+	template.AllResources(jsii.String("AWS::KMS::Key"), map[string]interface{}{
+		"DeletionPolicy": "Retain",
+		"Properties": assertions.Match_ObjectLike(&map[string]interface{}{
+			"EnableKeyRotation":    true,
+			"RotationPeriodInDays": 365,
+			"PendingWindowInDays":  30,
+		}),
+	})
+	for _, dataClass := range config.DataClasses() {
+		template.HasResourceProperties(jsii.String("AWS::KMS::Alias"), map[string]interface{}{
+			"AliasName": "alias/service-production-" + dataClass,
+		})
+		template.HasOutput(jsii.String("*"), map[string]interface{}{
+			"Export": map[string]interface{}{"Name": "Service-prod-" + dataClass + "-Key-Arn"},
+		})
+	}
+
+	// キーポリシー: アカウントには管理操作のみ、暗号化・復号は対応するサービス経由に限定
+	template.HasResourceProperties(jsii.String("AWS::KMS::Key"), map[string]interface{}{
+		"Description": "Customer managed key for database data of service-production",
+		"KeyPolicy": map[string]interface{}{
+			"Statement": assertions.Match_ArrayWith(&[]interface{}{
+				assertions.Match_ObjectLike(&map[string]interface{}{
+					"Sid":    "KeyAdministration",
+					"Action": assertions.Match_ArrayWith(&[]interface{}{"kms:ScheduleKeyDeletion"}),
+				}),
+				assertions.Match_ObjectLike(&map[string]interface{}{
+					"Sid":    "AllowUseViaService",
+					"Action": assertions.Match_ArrayWith(&[]interface{}{"kms:Decrypt", "kms:GenerateDataKey*"}),
+					"Condition": map[string]interface{}{
+						"StringEquals": map[string]interface{}{
+							"kms:ViaService":    []interface{}{map[string]interface{}{"Fn::Sub": "rds.${AWS::Region}.amazonaws.com"}},
+							"kms:CallerAccount": map[string]interface{}{"Ref": "AWS::AccountId"},
+						},
+					},
+				}),
+			}),
+		},
+	})
+	template.HasResourceProperties(jsii.String("AWS::KMS::Key"), map[string]interface{}{
+		"Description": "Customer managed key for logs data of service-production",
+		"KeyPolicy": map[string]interface{}{
+			"Statement": assertions.Match_ArrayWith(&[]interface{}{
+				assertions.Match_ObjectLike(&map[string]interface{}{
+					"Sid": "AllowCloudWatchLogs",
+				}),
+			}),
+		},
+	})
+
+	// kms:*（キー管理と使用の両方）はアカウントに許可しない
+	keys := template.FindResources(jsii.String("AWS::KMS::Key"), nil)
+	for _, key := range *keys {
+		policy := (*key)["Properties"].(map[string]interface{})["KeyPolicy"].(map[string]interface{})
+		for _, statement := range policy["Statement"].([]interface{}) {
+			assert.NotEqual(t, "kms:*", statement.(map[string]interface{})["Action"])
+		}
+	}
+}
+
+func TestKeyStack_Staging(t *testing.T) {
+	// Given
+	app := helpers.CreateTestAppForUnitTest("staging")
+
+	// When
+	stack := stacks.NewKeyStack(app, "TestKeyStack", &stacks.KeyStackProps{
+		Environment: "staging",
+	})
+
+	// Then: 削除待機期間を短くする
+	template := assertions.Template_FromStack(stack, nil)
+	template.AllResourcesProperties(jsii.String("AWS::KMS::Key"), map[string]interface{}{
+		"PendingWindowInDays": 7,
+	})
+	template.HasResourceProperties(jsii.String("AWS::KMS::Alias"), map[string]interface{}{
+		"AliasName": "alias/service-staging-secrets",
+	})
+}
+
+func TestKeyStack_SelectedDataClasses(t *testing.T) {
+	// Given: データベースとログのみCMKを使用
+	app := helpers.CreateTestAppForUnitTest("prod")
+
+	// When
+	stack := stacks.NewKeyStack(app, "TestKeyStack", &stacks.KeyStackProps{
+		Environment: "prod",
+		Encryption: &config.EncryptionConfig{
+			DataClasses:        []string{config.DataClassDatabase, config.DataClassLogs},
+			RotationPeriodDays: 180,
+			Migration:          config.EncryptionMigrationConfig{NameSuffix: "cmk"},
+		},
+	})
+
+	// Then
+	template := assertions.Template_FromStack(stack, nil)
+	template.ResourceCountIs(jsii.String("AWS::KMS::Key"), jsii.Number(2))
+	template.AllResourcesProperties(jsii.String("AWS::KMS::Key"), map[string]interface{}{
+		"RotationPeriodInDays": 180,
+		"PendingWindowInDays":  30,
+	})
+	template.HasOutput(jsii.String("DatabaseKeyArn"), map[string]interface{}{
+		"Export": map[string]interface{}{"Name": "Service-prod-database-Key-Arn"},
+	})
+	template.HasOutput(jsii.String("LogsKeyArn"), map[string]interface{}{
+		"Export": map[string]interface{}{"Name": "Service-prod-logs-Key-Arn"},
+	})
+}
+
+func TestKeyStack_InvalidConfiguration(t *testing.T) {
+	testCases := []struct {
+		name       string
+		encryption config.EncryptionConfig
+	}{
+		{
+			name:       "Unknown data class",
+			encryption: config.EncryptionConfig{DataClasses: []string{"media"}},
+		},
+		{
+			name:       "Duplicate data class",
+			encryption: config.EncryptionConfig{DataClasses: []string{config.DataClassLogs, config.DataClassLogs}},
+		},
+		{
+			name:       "Rotation period too short",
+			encryption: config.EncryptionConfig{DataClasses: []string{config.DataClassLogs}, RotationPeriodDays: 30},
+		},
+		{
+			name:       "Pending window too long",
+			encryption: config.EncryptionConfig{DataClasses: []string{config.DataClassLogs}, PendingWindowDays: 60},
+		},
+		{
+			name:       "Replaced resource without migration name suffix",
+			encryption: config.EncryptionConfig{DataClasses: []string{config.DataClassCache}},
+		},
+		{
+			name: "Invalid migration name suffix",
+			encryption: config.EncryptionConfig{
+				DataClasses: []string{config.DataClassDatabase},
+				Migration:   config.EncryptionMigrationConfig{NameSuffix: "CMK-2026"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			app := helpers.CreateTestAppForUnitTest("prod")
+			encryption := tc.encryption
+
+			// When & Then
+			assert.Panics(t, func() {
+				stacks.NewKeyStack(app, "TestKeyStack", &stacks.KeyStackProps{
+					Environment: "prod",
+					Encryption:  &encryption,
+				})
+			})
+		})
+	}
+}
+
+func TestStorageStack_CustomerManagedKeys(t *testing.T) {
+	// Given
+	app := helpers.CreateTestAppForUnitTest("prod")
+
+	// When
+	stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+		Environment: "prod",
+		VpcId:       "vpc-12345",
+		TestEnvFlag: true,
+	})
+
+	// Then: Aurora・ElastiCache・シークレット・バックアップ用バケットはデータ分類のキーで暗号化
+	template := assertions.Template_FromStack(stack, nil)
+	template.ResourceCountIs(jsii.String("AWS::KMS::Key"), jsii.Number(0))
+	template.HasResourceProperties(jsii.String("AWS::RDS::DBCluster"), map[string]interface{}{
+		"StorageEncrypted": true,
+		"KmsKeyId":         "arn:aws:kms:ap-northeast-1:123456789012:key/test-database-prod",
+	})
+	template.HasResourceProperties(jsii.String("AWS::ElastiCache::ReplicationGroup"), map[string]interface{}{
+		"KmsKeyId": "arn:aws:kms:ap-northeast-1:123456789012:key/test-cache-prod",
+	})
+	template.AllResourcesProperties(jsii.String("AWS::SecretsManager::Secret"), map[string]interface{}{
+		"KmsKeyId": "arn:aws:kms:ap-northeast-1:123456789012:key/test-secrets-prod",
+	})
+	template.HasResourceProperties(jsii.String("AWS::S3::Bucket"), map[string]interface{}{
+		"BucketName": assertions.Match_StringLikeRegexp(jsii.String("backups")),
+		"BucketEncryption": map[string]interface{}{
+			"ServerSideEncryptionConfiguration": []interface{}{
+				assertions.Match_ObjectLike(&map[string]interface{}{
+					"ServerSideEncryptionByDefault": map[string]interface{}{
+						"SSEAlgorithm":   "aws:kms",
+						"KMSMasterKeyID": "arn:aws:kms:ap-northeast-1:123456789012:key/test-backups-prod",
+					},
+				}),
+			},
+		},
+	})
+
+	// 既存のリソースは置換できないため、移行前のスナップショットから新しい物理名で作成
+	template.HasResourceProperties(jsii.String("AWS::RDS::DBCluster"), map[string]interface{}{
+		"DBClusterIdentifier": "service-production-aurora-cluster-cmk",
+		"SnapshotIdentifier":  "service-production-aurora-cluster-before-cmk",
+	})
+	template.HasResourceProperties(jsii.String("AWS::ElastiCache::ReplicationGroup"), map[string]interface{}{
+		"ReplicationGroupId": "service-production-redis-cmk",
+		"SnapshotName":       "service-production-redis-before-cmk",
+	})
+	template.HasOutput(jsii.String("EncryptionMigrationRunbook"), map[string]interface{}{
+		"Value": assertions.Match_StringLikeRegexp(jsii.String("--db-cluster-identifier service-production-aurora-cluster --db-cluster-snapshot-identifier service-production-aurora-cluster-before-cmk \\(restored into service-production-aurora-cluster-cmk\\)")),
+	})
+}
+
+func TestStorageStack_AWSManagedKeysInDevelopment(t *testing.T) {
+	// Given
+	app := helpers.CreateTestAppForUnitTest("dev")
+
+	// When
+	stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+		Environment: "dev",
+		VpcId:       "vpc-12345",
+		TestEnvFlag: true,
+	})
+
+	// Then: 開発環境はCMKを参照しない（既存の物理名のまま）
+	template := assertions.Template_FromStack(stack, nil)
+	template.HasResourceProperties(jsii.String("AWS::RDS::DBCluster"), map[string]interface{}{
+		"KmsKeyId":            assertions.Match_Absent(),
+		"DBClusterIdentifier": "service-development-aurora-cluster",
+	})
+	template.AllResourcesProperties(jsii.String("AWS::SecretsManager::Secret"), map[string]interface{}{
+		"KmsKeyId": assertions.Match_Absent(),
+	})
+}
+
+func TestApplicationStack_CustomerManagedKeys(t *testing.T) {
+	// Given
+	app := helpers.CreateTestAppForUnitTest("staging")
+
+	// When
+	stack := stacks.NewApplicationStack(app, "TestApplicationStack", &stacks.ApplicationStackProps{
+		Environment: "staging",
+		VpcId:       "vpc-12345",
+		TestEnvFlag: true,
+	})
+
+	// Then: ECRとロググループはデータ分類のキーで暗号化（ECRは新しいリポジトリ名で作成）
+	template := assertions.Template_FromStack(stack, nil)
+	template.HasResourceProperties(jsii.String("AWS::ECR::Repository"), map[string]interface{}{
+		"RepositoryName": "service-staging-cmk",
+		"EncryptionConfiguration": map[string]interface{}{
+			"EncryptionType": "KMS",
+			"KmsKey":         "arn:aws:kms:ap-northeast-1:123456789012:key/test-artifacts-staging",
+		},
+	})
+	template.HasResourceProperties(jsii.String("AWS::Logs::LogGroup"), map[string]interface{}{
+		"KmsKeyId": "arn:aws:kms:ap-northeast-1:123456789012:key/test-logs-staging",
+	})
+}
+
+func TestBackupStack_DedicatedVaultKey(t *testing.T) {
+	// Given: バックアップにCMKを使用しない設定
+	app := helpers.CreateTestAppForUnitTest("prod")
+
+	// When
+	stack := stacks.NewBackupStack(app, "TestBackupStack", &stacks.BackupStackProps{
+		Environment: "prod",
+		Encryption: &config.EncryptionConfig{
+			DataClasses: []string{config.DataClassDatabase},
+			Migration:   config.EncryptionMigrationConfig{NameSuffix: "cmk"},
+		},
+	})
+
+	// Then: ボールト専用のキーを作成
+	template := assertions.Template_FromStack(stack, nil)
+	template.HasResourceProperties(jsii.String("AWS::KMS::Alias"), map[string]interface{}{
+		"AliasName": "alias/service-production-backup",
+	})
+	template.HasResourceProperties(jsii.String("AWS::Backup::BackupVault"), map[string]interface{}{
+		"EncryptionKeyArn": map[string]interface{}{
+			"Fn::GetAtt": []interface{}{assertions.Match_StringLikeRegexp(jsii.String("^BackupVaultKey")), "Arn"},
+		},
+	})
+}
@@ -1,9 +1,13 @@
 package stacks_test
 
 import (
+	"aws-ecs-fargate-go-cdk/internal/config"
+	networkConstruct "aws-ecs-fargate-go-cdk/internal/constructs"
 	"aws-ecs-fargate-go-cdk/tests/helpers"
+	"strings"
 	"testing"
 
+	"github.com/aws/aws-cdk-go/awscdk/v2"
 	"github.com/aws/aws-cdk-go/awscdk/v2/assertions"
 	"github.com/aws/jsii-runtime-go"
 	"github.com/stretchr/testify/assert"
@@ -157,7 +161,7 @@ func TestNetworkStack_SecurityGroups(t *testing.T) {
 	template := assertions.Template_FromStack(stack, nil)
 
 	// 基本的なセキュリティグループ数の確認
-	template.ResourceCountIs(jsii.String("AWS::EC2::SecurityGroup"), jsii.Number(3))
+	template.ResourceCountIs(jsii.String("AWS::EC2::SecurityGroup"), jsii.Number(4))
 
 	// 各セキュリティグループの存在確認（基本プロパティのみ）
 	template.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroup"), map[string]interface{}{
@@ -175,6 +179,11 @@ func TestNetworkStack_SecurityGroups(t *testing.T) {
 		"GroupName":        "Service-dev-RDS-SG",
 	})
 
+	template.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroup"), map[string]interface{}{
+		"GroupDescription": "Security group for ElastiCache",
+		"GroupName":        "Service-dev-Cache-SG",
+	})
+
 	// CloudFormationテンプレートを直接検証する実用的なアプローチ
 	templateMap := template.ToJSON()
 	resources := (*templateMap)["Resources"].(map[string]interface{})
@@ -184,6 +193,7 @@ func TestNetworkStack_SecurityGroups(t *testing.T) {
 	albIngressRules := 0
 	ecsIngressRules := 0
 	rdsIngressRules := 0
+	cacheIngressRules := 0
 
 	for _, resource := range resources {
 		resourceData := resource.(map[string]interface{})
@@ -200,6 +210,8 @@ func TestNetworkStack_SecurityGroups(t *testing.T) {
 							ecsIngressRules = len(ingress)
 						case "Security group for RDS database":
 							rdsIngressRules = len(ingress)
+						case "Security group for ElastiCache":
+							cacheIngressRules = len(ingress)
 						}
 					}
 				}
@@ -208,10 +220,12 @@ func TestNetworkStack_SecurityGroups(t *testing.T) {
 	}
 
 	// セキュリティグループとルール数の実用的な検証
-	assert.Equal(t, 3, securityGroupCount, "Expected 3 security groups")
+	assert.Equal(t, 4, securityGroupCount, "Expected 4 security groups")
 	assert.Equal(t, 2, albIngressRules, "Expected 2 ingress rules for ALB (HTTP + HTTPS)")
-	assert.Equal(t, 2, ecsIngressRules, "Expected 2 ingress rules for ECS (HTTP + Dynamic ports from ALB)")
-	assert.Equal(t, 2, rdsIngressRules, "Expected 2 ingress rules for RDS (MySQL + Redis from ECS)")
+	assert.Equal(t, 1, ecsIngressRules, "Expected 1 ingress rule for ECS (container port 80 from ALB)")
+	// ECS → RDS / Cacheの許可はリソースを所有するStorageStackで作成
+	assert.Equal(t, 0, rdsIngressRules, "ECS to RDS access is granted by StorageStack")
+	assert.Equal(t, 0, cacheIngressRules, "ECS to Cache access is granted by StorageStack")
 
 	assert.NotNil(t, stack)
 }
@@ -310,6 +324,13 @@ func TestNetworkStack_CrossStackExports(t *testing.T) {
 		},
 	})
 
+	template.HasOutput(jsii.String("CacheSecurityGroupId"), map[string]interface{}{
+		"Description": "Cache Security Group ID",
+		"Export": map[string]interface{}{
+			"Name": "Service-prod-Cache-SG-Id",
+		},
+	})
+
 	// サブネット出力の確認
 	template.HasOutput(jsii.String("PrivateSubnetIds"), map[string]interface{}{
 		"Description": "Private Subnet IDs",
@@ -374,3 +395,315 @@ func TestNetworkStack_InvalidEnvironment(t *testing.T) {
 		})
 	}, "Should panic with invalid environment")
 }
+
+// セキュリティグループ定義によるティア追加テスト
+func TestNetworkStack_SecurityMatrixCustomTier(t *testing.T) {
+	// Given: 既定の定義にWorkerティアを追加
+	app := helpers.CreateTestApp(&helpers.TestAppConfig{
+		Environment: "dev",
+	})
+
+	matrix := config.GetSecurityMatrixConfig("dev")
+	matrix.Tiers = append(matrix.Tiers, config.SecurityTierConfig{
+		Name:        "Worker",
+		Description: "Security group for background workers",
+		Component:   "Worker",
+	})
+	matrix.Rules = append(matrix.Rules, config.SecurityRuleConfig{
+		Tier: "RDS", PeerType: config.PeerTypeTier, Peer: "Worker", Protocol: "tcp",
+		FromPort: 3306, ToPort: 3306, Description: "Allow MySQL traffic from Worker",
+	})
+
+	// When: NetworkStackを作成
+	stack := stacks.NewNetworkStack(app, "TestNetworkStack", &stacks.NetworkStackProps{
+		Environment:    "dev",
+		SecurityMatrix: matrix,
+	})
+
+	// Then: Workerティアのセキュリティグループと出力が作成される
+	template := assertions.Template_FromStack(stack, nil)
+	template.ResourceCountIs(jsii.String("AWS::EC2::SecurityGroup"), jsii.Number(5))
+	template.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroup"), map[string]interface{}{
+		"GroupDescription": "Security group for RDS database",
+		"SecurityGroupIngress": assertions.Match_ArrayWith(&[]interface{}{
+			assertions.Match_ObjectLike(&map[string]interface{}{
+				"Description": "Allow MySQL traffic from Worker",
+				"FromPort":    3306,
+			}),
+		}),
+	})
+	template.HasOutput(jsii.String("WorkerSecurityGroupId"), map[string]interface{}{
+		"Export": map[string]interface{}{
+			"Name": "Service-dev-Worker-SG-Id",
+		},
+	})
+
+	// 存在しないティアを参照する定義は検証エラー
+	invalid := config.GetSecurityMatrixConfig("dev")
+	invalid.Rules = append(invalid.Rules, config.SecurityRuleConfig{
+		Tier: "Unknown", PeerType: config.PeerTypeAnyIPv4, Protocol: "tcp", FromPort: 22, ToPort: 22,
+	})
+	assert.Error(t, config.ValidateSecurityMatrixConfig(invalid))
+}
+
+// 到達性テーブル出力テスト
+func TestSecurityMatrix_RenderReachability(t *testing.T) {
+	matrix := config.GetSecurityMatrixConfig("prod")
+
+	markdown := networkConstruct.RenderReachabilityMarkdown(matrix)
+	assert.Contains(t, markdown, "| Source | Destination | Protocol | Ports | Description |")
+	assert.Contains(t, markdown, "| ALB | ECS | tcp | 80 | Allow container port 80 from ALB |")
+	assert.Contains(t, markdown, "| 0.0.0.0/0 | ALB | tcp | 443 | Allow HTTPS traffic from internet |")
+
+	// Egressルールは送信元ティアから宛先への行として出力
+	restricted := config.BuildSecurityMatrixConfig("prod", config.SecurityMatrixOptions{
+		ALBExposure:    config.ALBExposurePublic,
+		RestrictEgress: true,
+	})
+	restrictedMarkdown := networkConstruct.RenderReachabilityMarkdown(restricted)
+	assert.Contains(t, restrictedMarkdown, "| ECS | Endpoints | tcp | 443 | Allow HTTPS from ECS to VPC endpoints |")
+	assert.Contains(t, restrictedMarkdown, "| ECS | AWS:s3 | tcp | 443 | Allow HTTPS to S3 (ECR image layers) |")
+
+	csvText, err := networkConstruct.RenderReachabilityCSV(matrix)
+	assert.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(csvText), "\n")
+	assert.Equal(t, len(matrix.Rules)+1, len(lines))
+	assert.Equal(t, "source,destination,protocol,ports,description", lines[0])
+}
+
+// ECS Ingressとコンテナ待受ポートの整合性テスト
+func TestNetworkStack_ECSIngressMatchesContainerPorts(t *testing.T) {
+	// Given
+	app := helpers.CreateTestApp(&helpers.TestAppConfig{
+		Environment: "dev",
+	})
+
+	// When: 既定の定義でNetworkStackを作成
+	stack := stacks.NewNetworkStack(app, "TestNetworkStack", &stacks.NetworkStackProps{
+		Environment: "dev",
+	})
+
+	// Then: ECSへのIngressはポートマッピング（80）のみ
+	template := assertions.Template_FromStack(stack, nil)
+	template.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroup"), map[string]interface{}{
+		"GroupDescription": "Security group for ECS tasks",
+		"SecurityGroupIngress": []interface{}{
+			assertions.Match_ObjectLike(&map[string]interface{}{
+				"IpProtocol": "tcp",
+				"FromPort":   80,
+				"ToPort":     80,
+			}),
+		},
+	})
+	assert.NoError(t, config.ValidateECSIngressRules(config.GetSecurityMatrixConfig("dev"), config.GetContainerPortConfigs("dev")))
+
+	// 待受ポートに対応しないルール（EC2動的ポート範囲）は検出される
+	matrix := config.GetSecurityMatrixConfig("dev")
+	matrix.Rules = append(matrix.Rules, config.SecurityRuleConfig{
+		Tier: "ECS", PeerType: config.PeerTypeTier, Peer: "ALB", Protocol: "tcp",
+		FromPort: 32768, ToPort: 65535, Description: "Allow dynamic port range from ALB",
+	})
+	assert.Error(t, config.ValidateECSIngressRules(matrix, config.GetContainerPortConfigs("dev")))
+
+	assert.Panics(t, func() {
+		stacks.NewNetworkStack(app, "InvalidNetworkStack", &stacks.NetworkStackProps{
+			Environment:    "dev",
+			SecurityMatrix: matrix,
+		})
+	}, "Should panic with ECS rule not matching a container port")
+}
+
+// ALB公開ポリシーテスト
+func TestNetworkStack_ALBExposurePolicies(t *testing.T) {
+	testCases := []struct {
+		name          string
+		environment   string
+		exposure      string
+		certificate   string
+		expectedRules []interface{}
+		prefixList    bool // プレフィックスリストのルールは個別リソースとして作成される
+	}{
+		{
+			name:        "Development - Allowlisted CIDRs",
+			environment: "dev",
+			expectedRules: []interface{}{
+				assertions.Match_ObjectLike(&map[string]interface{}{"CidrIp": "10.0.0.0/8", "FromPort": 80}),
+				assertions.Match_ObjectLike(&map[string]interface{}{"CidrIp": "10.0.0.0/8", "FromPort": 443}),
+			},
+		},
+		{
+			name:        "Production - Public",
+			environment: "prod",
+			expectedRules: []interface{}{
+				assertions.Match_ObjectLike(&map[string]interface{}{"CidrIp": "0.0.0.0/0", "FromPort": 80}),
+				assertions.Match_ObjectLike(&map[string]interface{}{"CidrIp": "0.0.0.0/0", "FromPort": 443}),
+			},
+		},
+		{
+			name:        "Staging - HTTPS Only",
+			environment: "staging",
+			exposure:    config.ALBExposureHTTPSOnly,
+			certificate: "arn:aws:acm:ap-northeast-1:123456789012:certificate/11111111-2222-3333-4444-555555555555",
+			expectedRules: []interface{}{
+				assertions.Match_ObjectLike(&map[string]interface{}{"CidrIp": "0.0.0.0/0", "FromPort": 443}),
+			},
+		},
+		{
+			name:        "Staging - CloudFront Only",
+			environment: "staging",
+			exposure:    config.ALBExposureCloudFront,
+			prefixList:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			app := helpers.CreateTestApp(&helpers.TestAppConfig{
+				Environment: tc.environment,
+			})
+
+			// When: NetworkStackを作成（Lookup用にアカウント・リージョンを指定）
+			stack := stacks.NewNetworkStack(app, "TestNetworkStack", &stacks.NetworkStackProps{
+				StackProps: awscdk.StackProps{
+					Env: &awscdk.Environment{
+						Account: jsii.String("123456789012"),
+						Region:  jsii.String("ap-northeast-1"),
+					},
+				},
+				Environment:    tc.environment,
+				ALBExposure:    tc.exposure,
+				ALBCertificate: tc.certificate,
+			})
+
+			// Then: ALBのIngressが公開ポリシー通りであること（過不足なし）
+			template := assertions.Template_FromStack(stack, nil)
+			if tc.prefixList {
+				template.ResourceCountIs(jsii.String("AWS::EC2::SecurityGroupIngress"), jsii.Number(1))
+				template.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroupIngress"), map[string]interface{}{
+					"SourcePrefixListId": assertions.Match_AnyValue(),
+					"FromPort":           80,
+					"ToPort":             80,
+				})
+				template.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroup"), map[string]interface{}{
+					"GroupDescription":     "Security group for ALB",
+					"SecurityGroupIngress": assertions.Match_Absent(),
+				})
+				return
+			}
+			template.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroup"), map[string]interface{}{
+				"GroupDescription":     "Security group for ALB",
+				"SecurityGroupIngress": tc.expectedRules,
+			})
+
+			assert.NotNil(t, stack)
+		})
+	}
+
+	// 許可CIDRのないallowlistは不正
+	assert.Error(t, config.ValidateALBExposure(config.ALBExposureAllowlist, nil, ""))
+	assert.Error(t, config.ValidateALBExposure("private", []string{"10.0.0.0/8"}, ""))
+
+	// HTTPSリスナーの証明書がないhttps-onlyは不正
+	assert.Error(t, config.ValidateALBExposure(config.ALBExposureHTTPSOnly, nil, ""))
+	assert.Error(t, config.ValidateALBExposure(config.ALBExposureHTTPSOnly, nil, "arn:aws:iam::123456789012:server-certificate/service"))
+	assert.Panics(t, func() {
+		stacks.NewNetworkStack(helpers.CreateTestApp(&helpers.TestAppConfig{Environment: "staging"}), "TestNetworkStack", &stacks.NetworkStackProps{
+			Environment: "staging",
+			ALBExposure: config.ALBExposureHTTPSOnly,
+		})
+	}, "Should panic with https-only and no ALB certificate")
+}
+
+// TestNetworkStack_RestrictEgress Egress制限時のセキュリティグループ・VPCエンドポイントのテスト
+func TestNetworkStack_RestrictEgress(t *testing.T) {
+	// Given
+	app := helpers.CreateTestApp(&helpers.TestAppConfig{
+		Environment: "prod",
+	})
+
+	// When: Egress制限を有効にしてNetworkStackを作成（Lookup用にアカウント・リージョンを指定）
+	stack := stacks.NewNetworkStack(app, "TestNetworkStack", &stacks.NetworkStackProps{
+		StackProps: awscdk.StackProps{
+			Env: &awscdk.Environment{
+				Account: jsii.String("123456789012"),
+				Region:  jsii.String("ap-northeast-1"),
+			},
+		},
+		Environment:    "prod",
+		RestrictEgress: true,
+	})
+
+	// Then: ALB・ECSの全送信許可が無効化されていること（Egressは個別リソースのみ）
+	template := assertions.Template_FromStack(stack, nil)
+	template.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroup"), map[string]interface{}{
+		"GroupDescription": "Security group for ALB",
+		"SecurityGroupEgress": []interface{}{
+			assertions.Match_ObjectLike(&map[string]interface{}{"CidrIp": "255.255.255.255/32"}),
+		},
+	})
+	template.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroup"), map[string]interface{}{
+		"GroupDescription":    "Security group for ECS tasks",
+		"SecurityGroupEgress": assertions.Match_Absent(),
+	})
+
+	// ティア間Ingressと対になるEgress（ALB→ECS、ECS→Endpoints）とS3へのEgressのみ
+	// （ECS→RDS/CacheはStorageStackでの許可時に作成）
+	template.ResourceCountIs(jsii.String("AWS::EC2::SecurityGroupEgress"), jsii.Number(3))
+	for _, port := range []int{80, 443} {
+		template.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroupEgress"), map[string]interface{}{
+			"DestinationSecurityGroupId": assertions.Match_AnyValue(),
+			"IpProtocol":                 "tcp",
+			"FromPort":                   port,
+			"ToPort":                     port,
+		})
+	}
+
+	// S3へのEgressはプレフィックスリスト経由
+	template.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroupEgress"), map[string]interface{}{
+		"DestinationPrefixListId": assertions.Match_AnyValue(),
+		"FromPort":                443,
+		"ToPort":                  443,
+	})
+
+	// VPCエンドポイント（S3ゲートウェイ + インターフェース4種）
+	template.ResourceCountIs(jsii.String("AWS::EC2::VPCEndpoint"), jsii.Number(5))
+	template.HasOutput(jsii.String("EndpointsSecurityGroupId"), map[string]interface{}{
+		"Export": map[string]interface{}{
+			"Name": "Service-prod-Endpoints-SG-Id",
+		},
+	})
+
+	// パブリックイメージはECR Publicのプルスルーキャッシュ経由で取得
+	template.HasResourceProperties(jsii.String("AWS::ECR::PullThroughCacheRule"), map[string]interface{}{
+		"EcrRepositoryPrefix": "service-prod-ecr-public",
+		"UpstreamRegistryUrl": "public.ecr.aws",
+	})
+
+	assert.NotNil(t, stack)
+}
+
+// TestNetworkStack_DefaultEgress Egress制限なしの場合は従来通り全送信を許可
+func TestNetworkStack_DefaultEgress(t *testing.T) {
+	// Given
+	app := helpers.CreateTestApp(&helpers.TestAppConfig{
+		Environment: "dev",
+	})
+
+	// When
+	stack := stacks.NewNetworkStack(app, "TestNetworkStack", &stacks.NetworkStackProps{
+		Environment: "dev",
+	})
+
+	// Then: VPCエンドポイントは作成されず、ECSは全送信を許可
+	template := assertions.Template_FromStack(stack, nil)
+	template.ResourceCountIs(jsii.String("AWS::EC2::VPCEndpoint"), jsii.Number(0))
+	template.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroup"), map[string]interface{}{
+		"GroupDescription": "Security group for ECS tasks",
+		"SecurityGroupEgress": []interface{}{
+			assertions.Match_ObjectLike(&map[string]interface{}{"CidrIp": "0.0.0.0/0", "IpProtocol": "-1"}),
+		},
+	})
+
+	assert.NotNil(t, stack)
+}
@@ -1,11 +1,14 @@
 package stacks_test
 
 import (
+	"aws-ecs-fargate-go-cdk/internal/config"
+	networkConstruct "aws-ecs-fargate-go-cdk/internal/constructs"
 	"aws-ecs-fargate-go-cdk/tests/helpers"
 	"testing"
 
 	"github.com/aws/aws-cdk-go/awscdk/v2"
 	"github.com/aws/aws-cdk-go/awscdk/v2/assertions"
+	"github.com/aws/aws-cdk-go/awscdk/v2/awsec2"
 	"github.com/aws/jsii-runtime-go"
 	"github.com/stretchr/testify/assert"
 
@@ -63,21 +66,21 @@ func TestStorageStack_EnvironmentConfigurations(t *testing.T) {
 			name:          "Development Environment",
 			environment:   "dev",
 			instanceCount: 1,
-			engineVersion: "5.7.12",
+			engineVersion: "8.0.mysql_aurora.3.08.0",
 			enableBackup:  false,
 		},
 		{
 			name:          "Staging Environment",
 			environment:   "staging",
 			instanceCount: 2,
-			engineVersion: "5.7.12",
+			engineVersion: "8.0.mysql_aurora.3.08.0",
 			enableBackup:  true,
 		},
 		{
 			name:          "Production Environment",
 			environment:   "prod",
 			instanceCount: 3,
-			engineVersion: "5.7.12",
+			engineVersion: "5.7.mysql_aurora.2.11.4",
 			enableBackup:  true,
 		},
 	}
@@ -207,174 +210,2534 @@ func TestStorageStack_ElastiCacheConfigurations(t *testing.T) {
 	}
 }
 
+// TestStorageStack_CacheAuth ElastiCacheの認証（AUTHトークン・ユーザーグループ）のテスト
+func TestStorageStack_CacheAuth(t *testing.T) {
+	t.Run("Development - AUTH Token", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("dev")
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment: "dev",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+		})
+
+		// Then: Secrets Managerで生成したAUTHトークンをレプリケーショングループに設定
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::SecretsManager::Secret"), map[string]interface{}{
+			"Name": "service-development-redis-credentials",
+			"GenerateSecretString": assertions.Match_ObjectLike(&map[string]interface{}{
+				"SecretStringTemplate": `{"username":"default"}`,
+				"GenerateStringKey":    "password",
+				"ExcludePunctuation":   true,
+			}),
+		})
+		template.HasResourceProperties(jsii.String("AWS::ElastiCache::ReplicationGroup"), map[string]interface{}{
+			"TransitEncryptionEnabled": true,
+			"AuthToken": map[string]interface{}{
+				"Fn::Join": assertions.Match_ArrayWith(&[]interface{}{
+					assertions.Match_ArrayWith(&[]interface{}{
+						"{{resolve:secretsmanager:",
+						map[string]interface{}{"Ref": assertions.Match_StringLikeRegexp(jsii.String("RedisAuthTokenSecret"))},
+					}),
+				}),
+			},
+			"UserGroupIds": assertions.Match_Absent(),
+		})
+		template.ResourceCountIs(jsii.String("AWS::ElastiCache::User"), jsii.Number(0))
+
+		// ApplicationStack向けにシークレットARNを出力
+		template.HasOutput(jsii.String("RedisSecretArn"), map[string]interface{}{
+			"Export": map[string]interface{}{"Name": "service-development-Redis-Secret-Arn"},
+		})
+	})
+
+	t.Run("Production - RBAC User Group with Rotation", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("prod")
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment: "prod",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+		})
+
+		// Then: 無効化したdefaultユーザー + 読み書き・読み取り専用ユーザー
+		template := assertions.Template_FromStack(stack, nil)
+		template.ResourceCountIs(jsii.String("AWS::ElastiCache::User"), jsii.Number(3))
+		template.HasResourceProperties(jsii.String("AWS::ElastiCache::User"), map[string]interface{}{
+			"UserId":             "service-production-redis-default",
+			"UserName":           "default",
+			"AccessString":       "off -@all",
+			"NoPasswordRequired": true,
+		})
+		template.HasResourceProperties(jsii.String("AWS::ElastiCache::User"), map[string]interface{}{
+			"UserId":       "service-production-redis-app",
+			"UserName":     "app",
+			"AccessString": "on ~* &* +@all -@admin",
+			"AuthenticationMode": map[string]interface{}{
+				"Type":      "password",
+				"Passwords": assertions.Match_AnyValue(),
+			},
+		})
+		template.HasResourceProperties(jsii.String("AWS::ElastiCache::User"), map[string]interface{}{
+			"UserId":       "service-production-redis-app-readonly",
+			"AccessString": "on ~* -@all +@read",
+		})
+		template.HasResourceProperties(jsii.String("AWS::ElastiCache::UserGroup"), map[string]interface{}{
+			"UserGroupId": "service-production-redis-users",
+			"Engine":      "redis",
+		})
+		template.HasResourceProperties(jsii.String("AWS::ElastiCache::ReplicationGroup"), map[string]interface{}{
+			"UserGroupIds": []interface{}{
+				map[string]interface{}{"Ref": assertions.Match_StringLikeRegexp(jsii.String("RedisUserGroup"))},
+			},
+			"AuthToken": assertions.Match_Absent(),
+		})
+
+		// 読み書きユーザーのシークレットはAUTHトークンと同じ名前
+		template.HasResourceProperties(jsii.String("AWS::SecretsManager::Secret"), map[string]interface{}{
+			"Name": "service-production-redis-credentials",
+			"GenerateSecretString": assertions.Match_ObjectLike(&map[string]interface{}{
+				"SecretStringTemplate": `{"username":"app","user_id":"service-production-redis-app"}`,
+			}),
+		})
+		template.HasOutput(jsii.String("RedisReadOnlySecretArn"), map[string]interface{}{
+			"Export": map[string]interface{}{"Name": "service-production-Redis-ReadOnly-Secret-Arn"},
+		})
+
+		// ローテーション: DB 2件 + Redisユーザー 2件
+		template.ResourceCountIs(jsii.String("AWS::SecretsManager::RotationSchedule"), jsii.Number(4))
+		template.HasResourceProperties(jsii.String("AWS::Lambda::Function"), map[string]interface{}{
+			"FunctionName": "service-production-redis-user-rotation",
+			"Runtime":      "python3.12",
+		})
+		template.HasResourceProperties(jsii.String("AWS::IAM::Policy"), map[string]interface{}{
+			"PolicyDocument": map[string]interface{}{
+				"Statement": assertions.Match_ArrayWith(&[]interface{}{
+					assertions.Match_ObjectLike(&map[string]interface{}{
+						"Action": []interface{}{"elasticache:ModifyUser", "elasticache:DescribeUsers"},
+					}),
+				}),
+			},
+		})
+	})
+
+	t.Run("Staging - RBAC without Rotation", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("staging")
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment: "staging",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+		})
+
+		// Then
+		template := assertions.Template_FromStack(stack, nil)
+		template.ResourceCountIs(jsii.String("AWS::ElastiCache::UserGroup"), jsii.Number(1))
+		rotations := template.FindResources(jsii.String("AWS::Lambda::Function"), map[string]interface{}{
+			"Properties": map[string]interface{}{"FunctionName": "service-staging-redis-user-rotation"},
+		})
+		assert.Empty(t, *rotations)
+	})
+
+	t.Run("Invalid Auth Config", func(t *testing.T) {
+		invalidAuths := []config.CacheAuthConfig{
+			{Mode: "none"},
+			{Mode: config.CacheAuthToken, RotationDays: 30},
+			{Mode: config.CacheAuthRBAC, ReadWriteUsername: "app", ReadOnlyUsername: "app"},
+			{Mode: config.CacheAuthRBAC, ReadWriteUsername: "default", ReadOnlyUsername: "app-readonly"},
+			{Mode: config.CacheAuthRBAC, ReadWriteUsername: "App_User", ReadOnlyUsername: "app-readonly"},
+		}
+
+		for _, auth := range invalidAuths {
+			// When & Then: 不正な設定はパニック
+			assert.Panics(t, func() {
+				stacks.NewStorageStack(CreateTestAppForStorageStack("dev"), "TestStorageStack", &stacks.StorageStackProps{
+					Environment: "dev",
+					VpcId:       "vpc-12345",
+					TestEnvFlag: true,
+					CacheAuth:   &auth,
+				})
+			}, "auth: %+v", auth)
+		}
+	})
+}
+
+// TestStorageStack_CacheEngineAndTopology ElastiCacheのエンジン・クラスターモード・パラメータグループのテスト
+func TestStorageStack_CacheEngineAndTopology(t *testing.T) {
+	t.Run("Default - Redis without Cluster Mode", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("prod")
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment: "prod",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+		})
+
+		// Then: エンジンのメジャーバージョンに対応するファミリーのカスタムパラメータグループ
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::ElastiCache::ParameterGroup"), map[string]interface{}{
+			"CacheParameterGroupFamily": "redis7",
+			"Properties": map[string]interface{}{
+				"cluster-enabled":  "no",
+				"maxmemory-policy": "volatile-lru",
+			},
+		})
+		template.HasResourceProperties(jsii.String("AWS::ElastiCache::ReplicationGroup"), map[string]interface{}{
+			"Engine":                  "redis",
+			"EngineVersion":           "7.1",
+			"NumCacheClusters":        3,
+			"NumNodeGroups":           assertions.Match_Absent(),
+			"CacheParameterGroupName": map[string]interface{}{"Ref": assertions.Match_StringLikeRegexp(jsii.String("RedisParameterGroup"))},
+		})
+
+		// 設定エンドポイントはクラスターモード有効時のみ
+		template.HasOutput(jsii.String("ElastiCacheEndpoint"), map[string]interface{}{
+			"Value": map[string]interface{}{
+				"Fn::GetAtt": []interface{}{"RedisCluster", "PrimaryEndPoint.Address"},
+			},
+		})
+		outputs := template.FindOutputs(jsii.String("ElastiCacheConfigurationEndpoint"), nil)
+		assert.Empty(t, *outputs)
+	})
+
+	t.Run("Valkey with Cluster Mode and Data Tiering", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("prod")
+
+		// When: Valkeyのシャーディング構成（r6gdノードでデータ階層化）
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment:      "prod",
+			VpcId:            "vpc-12345",
+			TestEnvFlag:      true,
+			CacheEngine:      config.CacheEngineValkey,
+			CacheTopology:    &config.CacheTopologyConfig{ClusterMode: true, NumShards: 3, ReplicasPerShard: 2},
+			CacheNodeType:    "cache.r6gd.xlarge",
+			CacheDataTiering: jsii.Bool(true),
+		})
+
+		// Then
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::ElastiCache::ParameterGroup"), map[string]interface{}{
+			"CacheParameterGroupFamily": "valkey8",
+			"Properties": assertions.Match_ObjectLike(&map[string]interface{}{
+				"cluster-enabled": "yes",
+			}),
+		})
+		template.HasResourceProperties(jsii.String("AWS::ElastiCache::ReplicationGroup"), map[string]interface{}{
+			"Engine":                   "valkey",
+			"EngineVersion":            "8.0",
+			"CacheNodeType":            "cache.r6gd.xlarge",
+			"DataTieringEnabled":       true,
+			"NumNodeGroups":            3,
+			"ReplicasPerNodeGroup":     2,
+			"NumCacheClusters":         assertions.Match_Absent(),
+			"AutomaticFailoverEnabled": true,
+			"MultiAZEnabled":           true,
+		})
+
+		// ユーザーもエンジンに合わせる
+		template.HasResourceProperties(jsii.String("AWS::ElastiCache::UserGroup"), map[string]interface{}{
+			"Engine": "valkey",
+		})
+
+		// ApplicationStackには設定エンドポイントを渡す
+		configurationEndpoint := map[string]interface{}{
+			"Fn::GetAtt": []interface{}{"RedisCluster", "ConfigurationEndPoint.Address"},
+		}
+		template.HasOutput(jsii.String("ElastiCacheConfigurationEndpoint"), map[string]interface{}{
+			"Value":  configurationEndpoint,
+			"Export": map[string]interface{}{"Name": "service-production-Redis-Configuration-Endpoint"},
+		})
+		template.HasOutput(jsii.String("ElastiCacheEndpoint"), map[string]interface{}{
+			"Value":       configurationEndpoint,
+			"Description": "ElastiCache Valkey Configuration Endpoint",
+			"Export":      map[string]interface{}{"Name": "service-production-Redis-Endpoint"},
+		})
+
+		// ECSからの許可の説明もエンジンに追従
+		template.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroupIngress"), map[string]interface{}{
+			"GroupId":               "sg-test-cache-prod",
+			"SourceSecurityGroupId": "sg-test-ecs-prod",
+			"Description":           "Allow Valkey traffic from ECS",
+		})
+	})
+
+	t.Run("Invalid Cache Config", func(t *testing.T) {
+		testCases := []struct {
+			name  string
+			props stacks.StorageStackProps
+		}{
+			{name: "Unsupported Engine", props: stacks.StorageStackProps{CacheEngine: "memcached"}},
+			{name: "Old Redis Version", props: stacks.StorageStackProps{CacheEngine: config.CacheEngineRedis, CacheEngineVersion: "5.0"}},
+			{name: "Invalid Version Format", props: stacks.StorageStackProps{CacheEngine: config.CacheEngineValkey, CacheEngineVersion: "8"}},
+			{name: "Data Tiering without r6gd", props: stacks.StorageStackProps{CacheDataTiering: jsii.Bool(true)}},
+			{name: "No Shards", props: stacks.StorageStackProps{CacheTopology: &config.CacheTopologyConfig{ClusterMode: true}}},
+			{name: "Too Many Replicas", props: stacks.StorageStackProps{CacheTopology: &config.CacheTopologyConfig{ClusterMode: true, NumShards: 1, ReplicasPerShard: 6}}},
+			{name: "Cluster Mode with Cache Clusters", props: stacks.StorageStackProps{CacheTopology: &config.CacheTopologyConfig{ClusterMode: true, NumShards: 1, NumCacheClusters: 2}}},
+			{name: "Cluster Enabled Parameter", props: stacks.StorageStackProps{CacheParameters: map[string]string{"cluster-enabled": "yes"}}},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				props := tc.props
+				props.Environment = "dev"
+				props.VpcId = "vpc-12345"
+				props.TestEnvFlag = true
+
+				// When & Then: 不正な設定はパニック
+				assert.Panics(t, func() {
+					stacks.NewStorageStack(CreateTestAppForStorageStack("dev"), "TestStorageStack", &props)
+				})
+			})
+		}
+	})
+}
+
+// TestStorageStack_CacheCluster ElastiCacheコンストラクトのサブネット・セキュリティグループ・エンドポイントのテスト
+func TestStorageStack_CacheCluster(t *testing.T) {
+	t.Run("Storage Stack Wiring", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("staging")
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment: "staging",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+		})
+
+		// Then: サブネットグループはVPCのプライベートサブネットから作成
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::ElastiCache::SubnetGroup"), map[string]interface{}{
+			"CacheSubnetGroupName": "service-staging-redis-cmk-subnet-group",
+			"SubnetIds":            []interface{}{"subnet-test-private-1-staging", "subnet-test-private-2-staging"},
+		})
+
+		// 既存リソースの論理IDを維持
+		resources := template.ToJSON()
+		for _, logicalId := range []string{"RedisSubnetGroup", "RedisParameterGroup", "RedisCluster"} {
+			assert.Contains(t, (*resources)["Resources"], logicalId)
+		}
+		template.HasResourceProperties(jsii.String("AWS::ElastiCache::ReplicationGroup"), map[string]interface{}{
+			"CacheSubnetGroupName": map[string]interface{}{"Ref": "RedisSubnetGroup"},
+			"SecurityGroupIds":     []interface{}{"sg-test-cache-staging"},
+		})
+
+		// クラスターモード無効時は読み取りエンドポイントも出力
+		template.HasOutput(jsii.String("ElastiCacheReaderEndpoint"), map[string]interface{}{
+			"Value": map[string]interface{}{
+				"Fn::GetAtt": []interface{}{"RedisCluster", "ReaderEndPoint.Address"},
+			},
+			"Export": map[string]interface{}{"Name": "service-staging-Redis-Reader-Endpoint"},
+		})
+	})
+
+	t.Run("Standalone Construct", func(t *testing.T) {
+		// Given
+		app := awscdk.NewApp(nil)
+		stack := awscdk.NewStack(app, jsii.String("TestCacheStack"), &awscdk.StackProps{
+			Env: &awscdk.Environment{Account: jsii.String("123456789012"), Region: jsii.String("ap-northeast-1")},
+		})
+		vpc := awsec2.NewVpc(stack, jsii.String("Vpc"), &awsec2.VpcProps{MaxAzs: jsii.Number(2)})
+		appSecurityGroup := awsec2.NewSecurityGroup(stack, jsii.String("AppSecurityGroup"), &awsec2.SecurityGroupProps{Vpc: vpc})
+		cacheConfig := config.GetCacheConfig("dev")
+		cacheConfig.Topology = config.CacheTopologyConfig{ClusterMode: true, NumShards: 2, ReplicasPerShard: 1}
+
+		// When: セキュリティグループを指定せずに作成
+		cache := networkConstruct.NewCacheCluster(stack, "Cache", &networkConstruct.CacheClusterProps{
+			Vpc:         vpc,
+			Config:      cacheConfig,
+			ClusterName: "test-cache",
+			Description: "Test cache",
+		})
+		cache.Connections().AllowDefaultPortFrom(appSecurityGroup, jsii.String("Allow cache traffic from app"))
+
+		// Then: 専用のセキュリティグループを作成し、Connectionsで既定ポートを許可
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroup"), map[string]interface{}{
+			"GroupName": "test-cache-sg",
+		})
+		template.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroupIngress"), map[string]interface{}{
+			"Description": "Allow cache traffic from app",
+			"FromPort":    6379,
+			"ToPort":      6379,
+		})
+
+		// クラスターモード有効時は設定エンドポイントのみ
+		assert.Nil(t, cache.PrimaryEndpoint())
+		assert.Nil(t, cache.ReaderEndpoint())
+		assert.NotNil(t, cache.ConfigurationEndpoint())
+		assert.Equal(t, cache.ConfigurationEndpoint(), cache.Endpoint())
+	})
+}
+
 func TestStorageStack_S3Buckets(t *testing.T) {
 	// Given
 	app := helpers.CreateTestApp(&helpers.TestAppConfig{
 		Environment: "dev",
 	})
 
-	// When: StorageStackを作成
-	stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
-		Environment: "dev",
-		VpcId:       "vpc-12345",
-		TestEnvFlag: true, // テスト環境フラグ
+	// When: StorageStackを作成
+	stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+		Environment: "dev",
+		VpcId:       "vpc-12345",
+		TestEnvFlag: true, // テスト環境フラグ
+	})
+
+	// Then: S3バケットの確認（静的アセット、ログ、バックアップ用）
+	template := assertions.Template_FromStack(stack, nil)
+	template.ResourceCountIs(jsii.String("AWS::S3::Bucket"), jsii.Number(3))
+
+	// 静的アセット用バケット確認
+	template.HasResourceProperties(jsii.String("AWS::S3::Bucket"), map[string]interface{}{
+		"BucketName": "service-development-static-assets",
+		"PublicAccessBlockConfiguration": map[string]interface{}{
+			"BlockPublicAcls":       true,
+			"BlockPublicPolicy":     true,
+			"IgnorePublicAcls":      true,
+			"RestrictPublicBuckets": true,
+		},
+	})
+
+	assert.NotNil(t, stack)
+}
+
+// TestStorageStack_BucketLifecycle S3バケットのライフサイクルルールのテスト
+func TestStorageStack_BucketLifecycle(t *testing.T) {
+	t.Run("Production", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("prod")
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment: "prod",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+		})
+
+		// Then: ログは段階的にアーカイブし2年で削除
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::S3::Bucket"), map[string]interface{}{
+			"BucketName": "service-production-logs",
+			"LifecycleConfiguration": map[string]interface{}{
+				"Rules": []interface{}{
+					map[string]interface{}{
+						"Id":     "service-lifecycle",
+						"Status": "Enabled",
+						"Transitions": []interface{}{
+							map[string]interface{}{"StorageClass": "STANDARD_IA", "TransitionInDays": 30},
+							map[string]interface{}{"StorageClass": "GLACIER_IR", "TransitionInDays": 90},
+							map[string]interface{}{"StorageClass": "DEEP_ARCHIVE", "TransitionInDays": 180},
+						},
+						"ExpirationInDays":               730,
+						"AbortIncompleteMultipartUpload": map[string]interface{}{"DaysAfterInitiation": 1},
+					},
+				},
+			},
+		})
+
+		// バックアップは現行バージョンを削除せず、非最新バージョンのみ削除
+		template.HasResourceProperties(jsii.String("AWS::S3::Bucket"), map[string]interface{}{
+			"BucketName": "service-production-backups",
+			"LifecycleConfiguration": map[string]interface{}{
+				"Rules": []interface{}{
+					assertions.Match_ObjectLike(&map[string]interface{}{
+						"ExpirationInDays": assertions.Match_Absent(),
+						"NoncurrentVersionTransitions": []interface{}{
+							map[string]interface{}{"StorageClass": "GLACIER_IR", "TransitionInDays": 30},
+						},
+						"NoncurrentVersionExpiration": map[string]interface{}{
+							"NoncurrentDays":          365,
+							"NewerNoncurrentVersions": 3,
+						},
+					}),
+				},
+			},
+		})
+
+		// 静的アセットは非最新バージョンのみ削除
+		template.HasResourceProperties(jsii.String("AWS::S3::Bucket"), map[string]interface{}{
+			"BucketName": "service-production-static-assets",
+			"LifecycleConfiguration": map[string]interface{}{
+				"Rules": []interface{}{
+					assertions.Match_ObjectLike(&map[string]interface{}{
+						"Transitions":      assertions.Match_Absent(),
+						"ExpirationInDays": assertions.Match_Absent(),
+						"NoncurrentVersionExpiration": map[string]interface{}{
+							"NoncurrentDays":          90,
+							"NewerNoncurrentVersions": 3,
+						},
+					}),
+				},
+			},
+		})
+	})
+
+	t.Run("Development", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("dev")
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment: "dev",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+		})
+
+		// Then: 移行せず短期間で削除
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::S3::Bucket"), map[string]interface{}{
+			"BucketName": "service-development-logs",
+			"LifecycleConfiguration": map[string]interface{}{
+				"Rules": []interface{}{
+					assertions.Match_ObjectLike(&map[string]interface{}{
+						"Transitions":      assertions.Match_Absent(),
+						"ExpirationInDays": 30,
+					}),
+				},
+			},
+		})
+	})
+
+	t.Run("No Lifecycle Rules", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("dev")
+		buckets := config.GetStorageBucketsConfig("dev")
+		buckets.Logs.Lifecycle = config.BucketLifecycleConfig{}
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment: "dev",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+			Buckets:     &buckets,
+		})
+
+		// Then
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::S3::Bucket"), map[string]interface{}{
+			"BucketName":             "service-development-logs",
+			"LifecycleConfiguration": assertions.Match_Absent(),
+		})
+	})
+
+	t.Run("Invalid Lifecycle Config", func(t *testing.T) {
+		testCases := []struct {
+			name      string
+			lifecycle config.BucketLifecycleConfig
+		}{
+			{name: "IA Before 30 Days", lifecycle: config.BucketLifecycleConfig{
+				Transitions: []config.BucketTransitionConfig{{StorageClass: config.StorageClassInfrequentAccess, Days: 7}},
+			}},
+			{name: "Reverse Storage Class Order", lifecycle: config.BucketLifecycleConfig{
+				Transitions: []config.BucketTransitionConfig{
+					{StorageClass: config.StorageClassDeepArchive, Days: 30},
+					{StorageClass: config.StorageClassGlacierInstantRetrieval, Days: 90},
+				},
+			}},
+			{name: "Too Soon After IA", lifecycle: config.BucketLifecycleConfig{
+				Transitions: []config.BucketTransitionConfig{
+					{StorageClass: config.StorageClassInfrequentAccess, Days: 30},
+					{StorageClass: config.StorageClassGlacierInstantRetrieval, Days: 45},
+				},
+			}},
+			{name: "Unsupported Storage Class", lifecycle: config.BucketLifecycleConfig{
+				Transitions: []config.BucketTransitionConfig{{StorageClass: "GLACIER", Days: 30}},
+			}},
+			{name: "Expiration Before Transition", lifecycle: config.BucketLifecycleConfig{
+				Transitions:    []config.BucketTransitionConfig{{StorageClass: config.StorageClassGlacierInstantRetrieval, Days: 90}},
+				ExpirationDays: 60,
+			}},
+			{name: "Noncurrent Rules without Versioning", lifecycle: config.BucketLifecycleConfig{
+				NoncurrentVersionExpirationDays: 30,
+			}},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				buckets := config.GetStorageBucketsConfig("dev")
+				buckets.Logs.Lifecycle = tc.lifecycle
+
+				// When & Then: 不正な設定はパニック
+				assert.Panics(t, func() {
+					stacks.NewStorageStack(CreateTestAppForStorageStack("dev"), "TestStorageStack", &stacks.StorageStackProps{
+						Environment: "dev",
+						VpcId:       "vpc-12345",
+						TestEnvFlag: true,
+						Buckets:     &buckets,
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestStorageStack_BucketReplication(t *testing.T) {
+	t.Run("Production DR Region", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("prod")
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment: "prod",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+		})
+
+		// Then: 大阪リージョンのバケットへRTC付きでレプリケーションし、宛先のKMSキーで再暗号化
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::S3::Bucket"), map[string]interface{}{
+			"BucketName": "service-production-backups",
+			"ReplicationConfiguration": map[string]interface{}{
+				"Role": map[string]interface{}{
+					"Fn::GetAtt": []interface{}{assertions.Match_StringLikeRegexp(jsii.String("^BackupsReplicationRole")), "Arn"},
+				},
+				"Rules": []interface{}{
+					map[string]interface{}{
+						"Id":                      "service-replication-1",
+						"Priority":                1,
+						"Status":                  "Enabled",
+						"Filter":                  map[string]interface{}{"Prefix": ""},
+						"DeleteMarkerReplication": map[string]interface{}{"Status": "Disabled"},
+						"SourceSelectionCriteria": map[string]interface{}{
+							"SseKmsEncryptedObjects": map[string]interface{}{"Status": "Enabled"},
+						},
+						"Destination": map[string]interface{}{
+							"Bucket": map[string]interface{}{
+								"Fn::Join": []interface{}{"", []interface{}{"arn:", map[string]interface{}{"Ref": "AWS::Partition"}, ":s3:::service-production-backups-dr"}},
+							},
+							"StorageClass": "GLACIER_IR",
+							"EncryptionConfiguration": map[string]interface{}{
+								"ReplicaKmsKeyID": map[string]interface{}{
+									"Fn::Sub": "arn:${AWS::Partition}:kms:ap-northeast-3:${AWS::AccountId}:alias/service-production-backups-dr",
+								},
+							},
+							"ReplicationTime": map[string]interface{}{"Status": "Enabled", "Time": map[string]interface{}{"Minutes": 15}},
+							"Metrics":         map[string]interface{}{"Status": "Enabled", "EventThreshold": map[string]interface{}{"Minutes": 15}},
+						},
+					},
+				},
+			},
+		})
+
+		// レプリケーションロール（宛先のKMSキーはエイリアスの条件で許可）
+		template.HasResourceProperties(jsii.String("AWS::IAM::Role"), map[string]interface{}{
+			"RoleName": "service-production-backups-replication",
+			"AssumeRolePolicyDocument": assertions.Match_ObjectLike(&map[string]interface{}{
+				"Statement": []interface{}{
+					assertions.Match_ObjectLike(&map[string]interface{}{
+						"Principal": map[string]interface{}{"Service": "s3.amazonaws.com"},
+					}),
+				},
+			}),
+		})
+		template.HasResourceProperties(jsii.String("AWS::IAM::Policy"), map[string]interface{}{
+			"PolicyDocument": assertions.Match_ObjectLike(&map[string]interface{}{
+				"Statement": assertions.Match_ArrayWith(&[]interface{}{
+					map[string]interface{}{
+						"Action": []interface{}{"kms:Encrypt", "kms:GenerateDataKey"},
+						"Effect": "Allow",
+						"Condition": map[string]interface{}{
+							"ForAnyValue:StringEquals": map[string]interface{}{
+								"kms:ResourceAliases": "alias/service-production-backups-dr",
+							},
+						},
+						"Resource": map[string]interface{}{
+							"Fn::Sub": "arn:${AWS::Partition}:kms:ap-northeast-3:${AWS::AccountId}:key/*",
+						},
+					},
+					assertions.Match_ObjectLike(&map[string]interface{}{
+						"Action": []interface{}{"s3:ReplicateObject", "s3:ReplicateDelete", "s3:ReplicateTags", "s3:ObjectOwnerOverrideToBucketOwner"},
+					}),
+				}),
+			}),
+		})
+		template.HasOutput(jsii.String("BackupsReplicationRoleArn"), map[string]interface{}{
+			"Export": map[string]interface{}{"Name": "service-production-Backups-Replication-Role-Arn"},
+		})
+	})
+
+	t.Run("Backup Account with Owner Override", func(t *testing.T) {
+		// Given: DRリージョンに加えてバックアップ専用アカウントにも複製
+		app := CreateTestAppForStorageStack("prod")
+		buckets := config.GetStorageBucketsConfig("prod")
+		buckets.Backups.Replication.Destinations = append(buckets.Backups.Replication.Destinations, config.BucketReplicationDestinationConfig{
+			BucketName:    "service-production-backups-vault",
+			Account:       "210987654321",
+			KmsKeyAlias:   "alias/backups-vault",
+			OwnerOverride: true,
+		})
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment: "prod",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+			Buckets:     &buckets,
+		})
+
+		// Then: 先頭の宛先ほど優先度が高く、別アカウントの宛先はレプリカの所有者を変更
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::S3::Bucket"), map[string]interface{}{
+			"BucketName": "service-production-backups",
+			"ReplicationConfiguration": assertions.Match_ObjectLike(&map[string]interface{}{
+				"Rules": []interface{}{
+					assertions.Match_ObjectLike(&map[string]interface{}{
+						"Id":       "service-replication-1",
+						"Priority": 2,
+					}),
+					assertions.Match_ObjectLike(&map[string]interface{}{
+						"Id":       "service-replication-2",
+						"Priority": 1,
+						"Destination": map[string]interface{}{
+							"Bucket": map[string]interface{}{
+								"Fn::Join": []interface{}{"", []interface{}{"arn:", map[string]interface{}{"Ref": "AWS::Partition"}, ":s3:::service-production-backups-vault"}},
+							},
+							"Account":                  "210987654321",
+							"AccessControlTranslation": map[string]interface{}{"Owner": "Destination"},
+							"EncryptionConfiguration": map[string]interface{}{
+								"ReplicaKmsKeyID": map[string]interface{}{
+									"Fn::Sub": "arn:${AWS::Partition}:kms:${AWS::Region}:210987654321:alias/backups-vault",
+								},
+							},
+						},
+					}),
+				},
+			}),
+		})
+	})
+
+	t.Run("Development", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("dev")
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment: "dev",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+		})
+
+		// Then: 開発環境はレプリケーションしない
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::S3::Bucket"), map[string]interface{}{
+			"BucketName":               "service-development-backups",
+			"ReplicationConfiguration": assertions.Match_Absent(),
+		})
+		template.ResourcePropertiesCountIs(jsii.String("AWS::IAM::Role"), map[string]interface{}{
+			"RoleName": "service-development-backups-replication",
+		}, jsii.Number(0))
+	})
+
+	t.Run("Invalid Replication Config", func(t *testing.T) {
+		valid := config.BucketReplicationDestinationConfig{
+			BucketName:  "service-development-backups-dr",
+			Region:      "ap-northeast-3",
+			KmsKeyAlias: "alias/service-development-backups-dr",
+		}
+		testCases := []struct {
+			name   string
+			modify func(b *config.StorageBucketsConfig)
+		}{
+			{name: "Without Versioning", modify: func(b *config.StorageBucketsConfig) {
+				b.Backups.Versioned = false
+				b.Backups.Lifecycle = config.BucketLifecycleConfig{}
+			}},
+			{name: "Logs Bucket", modify: func(b *config.StorageBucketsConfig) {
+				b.Logs.Versioned = true
+				b.Logs.Replication = b.Backups.Replication
+			}},
+			{name: "Missing KMS Key Alias", modify: func(b *config.StorageBucketsConfig) {
+				b.Backups.Replication.Destinations[0].KmsKeyAlias = ""
+			}},
+			{name: "AWS Managed KMS Key", modify: func(b *config.StorageBucketsConfig) {
+				b.Backups.Replication.Destinations[0].KmsKeyAlias = "alias/aws/s3"
+			}},
+			{name: "Invalid Region", modify: func(b *config.StorageBucketsConfig) {
+				b.Backups.Replication.Destinations[0].Region = "osaka"
+			}},
+			{name: "Invalid Account", modify: func(b *config.StorageBucketsConfig) {
+				b.Backups.Replication.Destinations[0].Account = "backup"
+			}},
+			{name: "Owner Override in Same Account", modify: func(b *config.StorageBucketsConfig) {
+				b.Backups.Replication.Destinations[0].OwnerOverride = true
+			}},
+			{name: "Duplicate Destination", modify: func(b *config.StorageBucketsConfig) {
+				b.Backups.Replication.Destinations = append(b.Backups.Replication.Destinations, b.Backups.Replication.Destinations[0])
+			}},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				buckets := config.GetStorageBucketsConfig("dev")
+				buckets.Backups.Replication.Destinations = []config.BucketReplicationDestinationConfig{valid}
+				tc.modify(&buckets)
+
+				// When & Then: 不正な設定はパニック
+				assert.Panics(t, func() {
+					stacks.NewStorageStack(CreateTestAppForStorageStack("dev"), "TestStorageStack", &stacks.StorageStackProps{
+						Environment: "dev",
+						VpcId:       "vpc-12345",
+						TestEnvFlag: true,
+						Buckets:     &buckets,
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestStorageStack_BucketObjectLock(t *testing.T) {
+	testCases := []struct {
+		name          string
+		mode          string
+		retentionDays int
+		expectedMode  string
+	}{
+		{name: "Governance Mode", mode: config.ObjectLockGovernance, retentionDays: 30, expectedMode: "GOVERNANCE"},
+		{name: "Compliance Mode", mode: config.ObjectLockCompliance, retentionDays: 365, expectedMode: "COMPLIANCE"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given: Object Lockを有効にした新しいバケット名を指定
+			app := CreateTestAppForStorageStack("prod")
+			buckets := config.GetStorageBucketsConfig("prod")
+			buckets.Backups.ObjectLock = config.BucketObjectLockConfig{
+				Enabled:       true,
+				Mode:          tc.mode,
+				RetentionDays: tc.retentionDays,
+				BucketName:    "service-production-backups-locked",
+			}
+
+			// When
+			stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+				Environment: "prod",
+				VpcId:       "vpc-12345",
+				TestEnvFlag: true,
+				Buckets:     &buckets,
+			})
+
+			// Then: 既定の保持期間付きでObject Lockが有効
+			template := assertions.Template_FromStack(stack, nil)
+			template.HasResourceProperties(jsii.String("AWS::S3::Bucket"), map[string]interface{}{
+				"BucketName":        "service-production-backups-locked",
+				"ObjectLockEnabled": true,
+				"ObjectLockConfiguration": map[string]interface{}{
+					"ObjectLockEnabled": "Enabled",
+					"Rule": map[string]interface{}{
+						"DefaultRetention": map[string]interface{}{
+							"Mode": tc.expectedMode,
+							"Days": tc.retentionDays,
+						},
+					},
+				},
+				"VersioningConfiguration": map[string]interface{}{"Status": "Enabled"},
+			})
+
+			// 出力も新しいバケット名を参照
+			template.HasOutput(jsii.String("BackupsBucketName"), map[string]interface{}{
+				"Value": map[string]interface{}{
+					"Ref": assertions.Match_StringLikeRegexp(jsii.String("^BackupsBucket")),
+				},
+			})
+		})
+	}
+
+	t.Run("Disabled by Default", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("prod")
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment: "prod",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+		})
+
+		// Then: 既存のバケットはそのまま
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::S3::Bucket"), map[string]interface{}{
+			"BucketName":              "service-production-backups",
+			"ObjectLockEnabled":       assertions.Match_Absent(),
+			"ObjectLockConfiguration": assertions.Match_Absent(),
+		})
+	})
+
+	t.Run("Invalid Object Lock Config", func(t *testing.T) {
+		valid := config.BucketObjectLockConfig{
+			Enabled:       true,
+			Mode:          config.ObjectLockGovernance,
+			RetentionDays: 30,
+			BucketName:    "service-development-backups-locked",
+		}
+		testCases := []struct {
+			name   string
+			modify func(b *config.StorageBucketsConfig)
+		}{
+			{name: "Existing Bucket Name", modify: func(b *config.StorageBucketsConfig) {
+				b.Backups.ObjectLock.BucketName = "service-development-backups"
+			}},
+			{name: "Missing Bucket Name", modify: func(b *config.StorageBucketsConfig) {
+				b.Backups.ObjectLock.BucketName = ""
+			}},
+			{name: "Unsupported Mode", modify: func(b *config.StorageBucketsConfig) {
+				b.Backups.ObjectLock.Mode = "LEGAL_HOLD"
+			}},
+			{name: "Zero Retention", modify: func(b *config.StorageBucketsConfig) {
+				b.Backups.ObjectLock.RetentionDays = 0
+			}},
+			{name: "Without Versioning", modify: func(b *config.StorageBucketsConfig) {
+				b.Backups.Versioned = false
+				b.Backups.Lifecycle = config.BucketLifecycleConfig{}
+			}},
+			{name: "Logs Bucket", modify: func(b *config.StorageBucketsConfig) {
+				b.Logs.Versioned = true
+				b.Logs.ObjectLock = b.Backups.ObjectLock
+			}},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				buckets := config.GetStorageBucketsConfig("dev")
+				buckets.Backups.ObjectLock = valid
+				tc.modify(&buckets)
+
+				// When & Then: 不正な設定はパニック
+				assert.Panics(t, func() {
+					stacks.NewStorageStack(CreateTestAppForStorageStack("dev"), "TestStorageStack", &stacks.StorageStackProps{
+						Environment: "dev",
+						VpcId:       "vpc-12345",
+						TestEnvFlag: true,
+						Buckets:     &buckets,
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestStorageStack_AccessLogs(t *testing.T) {
+	t.Run("S3 Server Access Logs", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("dev")
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment: "dev",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+		})
+
+		// Then: 静的アセット・バックアップはバケット別のプレフィックスでログ用バケットに出力
+		template := assertions.Template_FromStack(stack, nil)
+		for bucketName, prefix := range map[string]string{
+			"service-development-static-assets": "s3-access/static-assets/",
+			"service-development-backups":       "s3-access/backups/",
+		} {
+			template.HasResourceProperties(jsii.String("AWS::S3::Bucket"), map[string]interface{}{
+				"BucketName": bucketName,
+				"LoggingConfiguration": map[string]interface{}{
+					"DestinationBucketName": map[string]interface{}{
+						"Ref": assertions.Match_StringLikeRegexp(jsii.String("^LogsBucket")),
+					},
+					"LogFilePrefix": prefix,
+				},
+			})
+		}
+
+		// ログ用バケット自体はログを出力しない（ループ防止）
+		template.HasResourceProperties(jsii.String("AWS::S3::Bucket"), map[string]interface{}{
+			"BucketName":           "service-development-logs",
+			"LoggingConfiguration": assertions.Match_Absent(),
+		})
+
+		// 出力元のバケットからのみログ配信を許可
+		template.HasResourceProperties(jsii.String("AWS::S3::BucketPolicy"), map[string]interface{}{
+			"PolicyDocument": assertions.Match_ObjectLike(&map[string]interface{}{
+				"Statement": assertions.Match_ArrayWith(&[]interface{}{
+					assertions.Match_ObjectLike(&map[string]interface{}{
+						"Action":    "s3:PutObject",
+						"Principal": map[string]interface{}{"Service": "logging.s3.amazonaws.com"},
+						"Condition": assertions.Match_ObjectLike(&map[string]interface{}{
+							"ArnLike": map[string]interface{}{
+								"aws:SourceArn": map[string]interface{}{
+									"Fn::GetAtt": []interface{}{assertions.Match_StringLikeRegexp(jsii.String("^StaticAssetsBucket")), "Arn"},
+								},
+							},
+						}),
+					}),
+				}),
+			}),
+		})
+	})
+
+	t.Run("ALB Log Delivery", func(t *testing.T) {
+		// Given: リージョンが確定している場合はリージョン別のELBアカウントを許可
+		app := CreateTestAppForStorageStack("prod")
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			StackProps: awscdk.StackProps{
+				Env: &awscdk.Environment{
+					Account: jsii.String("123456789012"),
+					Region:  jsii.String("ap-northeast-1"),
+				},
+			},
+			Environment: "prod",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+		})
+
+		// Then: アクセスログ・接続ログのプレフィックスにのみ書き込みを許可
+		template := assertions.Template_FromStack(stack, nil)
+		for _, prefix := range []string{"alb/access", "alb/connection"} {
+			template.HasResourceProperties(jsii.String("AWS::S3::BucketPolicy"), map[string]interface{}{
+				"PolicyDocument": assertions.Match_ObjectLike(&map[string]interface{}{
+					"Statement": assertions.Match_ArrayWith(&[]interface{}{
+						map[string]interface{}{
+							"Action": "s3:PutObject",
+							"Effect": "Allow",
+							"Principal": map[string]interface{}{
+								"AWS": map[string]interface{}{
+									"Fn::Join": []interface{}{"", []interface{}{"arn:", map[string]interface{}{"Ref": "AWS::Partition"}, ":iam::582318560864:root"}},
+								},
+							},
+							"Resource": map[string]interface{}{
+								"Fn::Join": []interface{}{"", []interface{}{
+									map[string]interface{}{"Fn::GetAtt": []interface{}{assertions.Match_StringLikeRegexp(jsii.String("^LogsBucket")), "Arn"}},
+									"/" + prefix + "/AWSLogs/123456789012/*",
+								}},
+							},
+						},
+					}),
+				}),
+			})
+		}
+	})
+
+	t.Run("Logging Loop", func(t *testing.T) {
+		// Given: ログ用バケット自体のサーバーアクセスログを有効化
+		buckets := config.GetStorageBucketsConfig("dev")
+		buckets.Logs.ServerAccessLogs = true
+
+		// When & Then: パニック
+		assert.Panics(t, func() {
+			stacks.NewStorageStack(CreateTestAppForStorageStack("dev"), "TestStorageStack", &stacks.StorageStackProps{
+				Environment: "dev",
+				VpcId:       "vpc-12345",
+				TestEnvFlag: true,
+				Buckets:     &buckets,
+			})
+		})
+	})
+}
+
+func TestStorageStack_StaticAssetsCDN(t *testing.T) {
+	t.Run("Production", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("prod")
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment: "prod",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+		})
+
+		// Then: OAC経由で静的アセット用バケットを配信し、パス別のキャッシュポリシーを設定順に適用
+		template := assertions.Template_FromStack(stack, nil)
+		template.ResourceCountIs(jsii.String("AWS::CloudFront::Distribution"), jsii.Number(1))
+		template.ResourceCountIs(jsii.String("AWS::CloudFront::OriginAccessControl"), jsii.Number(1))
+		template.HasResourceProperties(jsii.String("AWS::CloudFront::Distribution"), map[string]interface{}{
+			"DistributionConfig": assertions.Match_ObjectLike(&map[string]interface{}{
+				"PriceClass":  "PriceClass_All",
+				"HttpVersion": "http2and3",
+				"Origins": []interface{}{
+					assertions.Match_ObjectLike(&map[string]interface{}{
+						"DomainName": map[string]interface{}{
+							"Fn::GetAtt": []interface{}{assertions.Match_StringLikeRegexp(jsii.String("^StaticAssetsBucket")), "RegionalDomainName"},
+						},
+						"OriginAccessControlId": map[string]interface{}{
+							"Fn::GetAtt": []interface{}{assertions.Match_AnyValue(), "Id"},
+						},
+					}),
+				},
+				"DefaultCacheBehavior": assertions.Match_ObjectLike(&map[string]interface{}{
+					"ViewerProtocolPolicy": "redirect-to-https",
+					"AllowedMethods":       []interface{}{"GET", "HEAD", "OPTIONS"},
+				}),
+				"CacheBehaviors": []interface{}{
+					assertions.Match_ObjectLike(&map[string]interface{}{"PathPattern": "/build/*"}),
+					assertions.Match_ObjectLike(&map[string]interface{}{"PathPattern": "/images/*"}),
+				},
+			}),
+		})
+
+		// パス別のTTL（ビルド成果物は1年間キャッシュ）
+		template.HasResourceProperties(jsii.String("AWS::CloudFront::CachePolicy"), map[string]interface{}{
+			"CachePolicyConfig": assertions.Match_ObjectLike(&map[string]interface{}{
+				"Name":       "service-production-static-assets-1",
+				"DefaultTTL": 31536000,
+				"MaxTTL":     31536000,
+				"MinTTL":     0,
+			}),
+		})
+		template.HasResourceProperties(jsii.String("AWS::CloudFront::CachePolicy"), map[string]interface{}{
+			"CachePolicyConfig": assertions.Match_ObjectLike(&map[string]interface{}{
+				"Name":       "service-production-static-assets-default",
+				"DefaultTTL": 86400,
+			}),
+		})
+
+		// バケットポリシーはこのディストリビューションからの読み取りのみ許可
+		template.HasResourceProperties(jsii.String("AWS::S3::BucketPolicy"), map[string]interface{}{
+			"Bucket": map[string]interface{}{
+				"Ref": assertions.Match_StringLikeRegexp(jsii.String("^StaticAssetsBucket")),
+			},
+			"PolicyDocument": assertions.Match_ObjectLike(&map[string]interface{}{
+				"Statement": assertions.Match_ArrayWith(&[]interface{}{
+					assertions.Match_ObjectLike(&map[string]interface{}{
+						"Action":    "s3:GetObject",
+						"Principal": map[string]interface{}{"Service": "cloudfront.amazonaws.com"},
+						"Condition": map[string]interface{}{
+							"StringEquals": map[string]interface{}{
+								"AWS:SourceArn": map[string]interface{}{
+									"Fn::Join": []interface{}{"", assertions.Match_ArrayWith(&[]interface{}{
+										map[string]interface{}{"Ref": assertions.Match_StringLikeRegexp(jsii.String("^StaticAssetsCDNDistribution"))},
+									})},
+								},
+							},
+						},
+					}),
+				}),
+			}),
+		})
+
+		// アプリケーションに注入するアセットURLをExport
+		template.HasOutput(jsii.String("StaticAssetsUrl"), map[string]interface{}{
+			"Export": map[string]interface{}{"Name": "service-production-Static-Assets-Url"},
+		})
+		template.HasOutput(jsii.String("StaticAssetsDistributionId"), map[string]interface{}{
+			"Export": map[string]interface{}{"Name": "service-production-Static-Distribution-Id"},
+		})
+	})
+
+	t.Run("Custom Domain", func(t *testing.T) {
+		// Given: us-east-1の証明書でカスタムドメインを設定
+		app := CreateTestAppForStorageStack("staging")
+		cdn := config.GetCDNConfig("staging")
+		cdn.DomainName = "assets.staging.example.com"
+		cdn.CertificateArn = "arn:aws:acm:us-east-1:123456789012:certificate/11111111-2222-3333-4444-555555555555"
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment: "staging",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+			CDN:         &cdn,
+		})
+
+		// Then
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::CloudFront::Distribution"), map[string]interface{}{
+			"DistributionConfig": assertions.Match_ObjectLike(&map[string]interface{}{
+				"Aliases": []interface{}{"assets.staging.example.com"},
+				"ViewerCertificate": map[string]interface{}{
+					"AcmCertificateArn":      cdn.CertificateArn,
+					"MinimumProtocolVersion": "TLSv1.2_2021",
+					"SslSupportMethod":       "sni-only",
+				},
+			}),
+		})
+
+		// アセットURLはカスタムドメイン
+		template.HasOutput(jsii.String("StaticAssetsUrl"), map[string]interface{}{
+			"Value": "https://assets.staging.example.com",
+		})
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("dev")
+		cdn := config.GetCDNConfig("dev")
+		cdn.Enabled = false
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment: "dev",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+			CDN:         &cdn,
+		})
+
+		// Then
+		template := assertions.Template_FromStack(stack, nil)
+		template.ResourceCountIs(jsii.String("AWS::CloudFront::Distribution"), jsii.Number(0))
+		template.ResourceCountIs(jsii.String("AWS::CloudFront::CachePolicy"), jsii.Number(0))
+		outputs := template.FindOutputs(jsii.String("StaticAssetsUrl"), nil)
+		assert.Empty(t, *outputs)
+	})
+
+	t.Run("Invalid CDN Config", func(t *testing.T) {
+		testCases := []struct {
+			name   string
+			modify func(c *config.CDNConfig)
+		}{
+			{name: "Certificate Outside us-east-1", modify: func(c *config.CDNConfig) {
+				c.DomainName = "assets.example.com"
+				c.CertificateArn = "arn:aws:acm:ap-northeast-1:123456789012:certificate/11111111-2222-3333-4444-555555555555"
+			}},
+			{name: "Domain without Certificate", modify: func(c *config.CDNConfig) {
+				c.DomainName = "assets.example.com"
+			}},
+			{name: "Default TTL Above Max", modify: func(c *config.CDNConfig) {
+				c.DefaultCache = config.CDNCacheConfig{DefaultTTLSeconds: 600, MaxTTLSeconds: 300}
+			}},
+			{name: "Duplicate Path Pattern", modify: func(c *config.CDNConfig) {
+				c.Behaviors = append(c.Behaviors, c.Behaviors[0])
+			}},
+			{name: "Unsupported Price Class", modify: func(c *config.CDNConfig) {
+				c.PriceClass = "PriceClass_300"
+			}},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				cdn := config.GetCDNConfig("dev")
+				tc.modify(&cdn)
+
+				// When & Then: 不正な設定はパニック
+				assert.Panics(t, func() {
+					stacks.NewStorageStack(CreateTestAppForStorageStack("dev"), "TestStorageStack", &stacks.StorageStackProps{
+						Environment: "dev",
+						VpcId:       "vpc-12345",
+						TestEnvFlag: true,
+						CDN:         &cdn,
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestStorageStack_CrossStackExports(t *testing.T) {
+	// Given
+	app := helpers.CreateTestApp(&helpers.TestAppConfig{
+		Environment: "dev",
+	})
+
+	// When: StorageStackを作成
+	stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+		Environment: "dev",
+		VpcId:       "vpc-12345",
+	})
+
+	// Then: Cross-stack出力の確認
+	template := assertions.Template_FromStack(stack, nil)
+
+	// Aurora Endpoint出力
+	template.HasOutput(jsii.String("AuroraClusterEndpoint"), map[string]interface{}{
+		"Description": "Aurora MySQL Cluster Writer Endpoint",
+		"Export": map[string]interface{}{
+			"Name": "service-development-Aurora-Endpoint",
+		},
+	})
+
+	// ElastiCache Endpoint出力
+	template.HasOutput(jsii.String("ElastiCacheEndpoint"), map[string]interface{}{
+		"Description": "ElastiCache Redis Primary Endpoint",
+		"Export": map[string]interface{}{
+			"Name": "service-development-Redis-Endpoint",
+		},
+	})
+
+	// S3 Bucket名出力
+	template.HasOutput(jsii.String("StaticAssetsBucketName"), map[string]interface{}{
+		"Description": "Static Assets S3 Bucket Name",
+		"Export": map[string]interface{}{
+			"Name": "service-development-Static-Bucket",
+		},
+	})
+
+	assert.NotNil(t, stack)
+}
+
+// TestStorageStack_SecuritySettings セキュリティ設定のテスト
+func TestStorageStack_SecuritySettings(t *testing.T) {
+	// Given
+	app := CreateTestAppForStorageStack("prod")
+
+	// When
+	stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+		Environment: "prod",
+		VpcId:       "vpc-12345",
+		TestEnvFlag: true, // テスト環境フラグ
+	})
+
+	// Then
+	template := assertions.Template_FromStack(stack, nil)
+
+	// Aurora暗号化設定確認
+	template.HasResourceProperties(jsii.String("AWS::RDS::DBCluster"), map[string]interface{}{
+		"StorageEncrypted":   true,
+		"DeletionProtection": true, // 本番環境では削除保護有効
+	})
+
+	// ElastiCache暗号化設定確認
+	template.HasResourceProperties(jsii.String("AWS::ElastiCache::ReplicationGroup"), map[string]interface{}{
+		"AtRestEncryptionEnabled":  true,
+		"TransitEncryptionEnabled": true,
+	})
+
+	// S3バケット暗号化設定確認
+	template.HasResourceProperties(jsii.String("AWS::S3::Bucket"), map[string]interface{}{
+		"BucketEncryption": map[string]interface{}{
+			"ServerSideEncryptionConfiguration": assertions.Match_AnyValue(),
+		},
+		"PublicAccessBlockConfiguration": map[string]interface{}{
+			"BlockPublicAcls":       true,
+			"BlockPublicPolicy":     true,
+			"IgnorePublicAcls":      true,
+			"RestrictPublicBuckets": true,
+		},
+	})
+
+	assert.NotNil(t, stack)
+}
+
+// TestStorageStack_InstanceTypes Aurora環境別インスタンスタイプのテスト
+func TestStorageStack_InstanceTypes(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		environment          string
+		expectedInstanceType string
+		expectedInstances    int
+	}{
+		{
+			name:                 "Development - Serverless v2",
+			environment:          "dev",
+			expectedInstanceType: "db.serverless",
+			expectedInstances:    1,
+		},
+		{
+			name:                 "Staging - Serverless v2",
+			environment:          "staging",
+			expectedInstanceType: "db.serverless",
+			expectedInstances:    2,
+		},
+		{
+			name:                 "Production - Large Instance",
+			environment:          "prod",
+			expectedInstanceType: "db.r5.large",
+			expectedInstances:    3,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			app := CreateTestAppForStorageStack(tc.environment)
+
+			// When
+			stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+				Environment: tc.environment,
+				VpcId:       "vpc-12345",
+				TestEnvFlag: true, // テスト環境フラグ
+			})
+
+			// Then: RDS設定の確認
+			template := assertions.Template_FromStack(stack, nil)
+
+			// Aurora Instance数確認
+			template.ResourceCountIs(jsii.String("AWS::RDS::DBInstance"), jsii.Number(tc.expectedInstances))
+			template.HasResourceProperties(jsii.String("AWS::RDS::DBInstance"), map[string]interface{}{
+				"DBInstanceClass": tc.expectedInstanceType,
+			})
+
+			assert.NotNil(t, stack)
+		})
+	}
+}
+
+// TestStorageStack_DatabaseCapacityModes Aurora容量モードのテスト
+func TestStorageStack_DatabaseCapacityModes(t *testing.T) {
+	t.Run("Serverless v2 ACU range", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("dev")
+
+		// When: 環境設定（Serverless v2）でStorageStackを作成
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment: "dev",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+		})
+
+		// Then: クラスターにACU範囲が設定される
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBCluster"), map[string]interface{}{
+			"ServerlessV2ScalingConfiguration": map[string]interface{}{
+				"MinCapacity": 0.5,
+				"MaxCapacity": 2,
+			},
+		})
+	})
+
+	t.Run("Provisioned writer with serverless readers", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("prod")
+
+		// When: プロビジョンドWriter + Serverless v2 Reader（Aurora MySQL 3系）を指定
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment:           "prod",
+			VpcId:                 "vpc-12345",
+			TestEnvFlag:           true,
+			DatabaseEngine:        config.DatabaseEngineAuroraMySQL,
+			DatabaseEngineVersion: "3.08.0",
+			DatabaseCapacity: &config.DatabaseCapacityConfig{
+				Mode:                   config.DatabaseCapacityMixed,
+				InstanceCount:          3,
+				InstanceClass:          "r6g.large",
+				MinACU:                 2,
+				MaxACU:                 16,
+				ReadersScaleWithWriter: true,
+			},
+		})
+
+		// Then: Writerはプロビジョンド、ReaderはWriterに追従する昇格ティアのServerless v2
+		template := assertions.Template_FromStack(stack, nil)
+		template.ResourceCountIs(jsii.String("AWS::RDS::DBInstance"), jsii.Number(3))
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBInstance"), map[string]interface{}{
+			"DBInstanceClass": "db.r6g.large",
+			"PromotionTier":   0,
+		})
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBInstance"), map[string]interface{}{
+			"DBInstanceClass": "db.serverless",
+			"PromotionTier":   1,
+		})
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBCluster"), map[string]interface{}{
+			"ServerlessV2ScalingConfiguration": map[string]interface{}{
+				"MinCapacity": 2,
+				"MaxCapacity": 16,
+			},
+		})
+	})
+
+	t.Run("Provisioned only", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("prod")
+
+		// When: 環境設定（プロビジョンド）でStorageStackを作成
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment: "prod",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+		})
+
+		// Then: Serverless v2の容量設定は付与されない
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBCluster"), map[string]interface{}{
+			"ServerlessV2ScalingConfiguration": assertions.Match_Absent(),
+		})
+	})
+
+	t.Run("Invalid ACU range", func(t *testing.T) {
+		app := CreateTestAppForStorageStack("dev")
+
+		assert.Panics(t, func() {
+			stacks.NewStorageStack(app, "InvalidStorageStack", &stacks.StorageStackProps{
+				Environment: "dev",
+				VpcId:       "vpc-12345",
+				TestEnvFlag: true,
+				DatabaseCapacity: &config.DatabaseCapacityConfig{
+					Mode:          config.DatabaseCapacityServerless,
+					InstanceCount: 1,
+					MinACU:        8,
+					MaxACU:        4,
+				},
+			})
+		}, "Should panic when min ACU exceeds max ACU")
+	})
+}
+
+// TestStorageStack_DatabaseEngines Auroraエンジン選択のテスト
+func TestStorageStack_DatabaseEngines(t *testing.T) {
+	t.Run("Aurora PostgreSQL", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("staging")
+
+		// When: Aurora PostgreSQLを指定
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment:           "staging",
+			VpcId:                 "vpc-12345",
+			TestEnvFlag:           true,
+			DatabaseEngine:        config.DatabaseEngineAuroraPostgreSQL,
+			DatabaseEngineVersion: "16.4",
+		})
+
+		// Then: ポート・ログ・パラメータグループ・SGの許可がエンジンに追従
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBCluster"), map[string]interface{}{
+			"Engine":                      "aurora-postgresql",
+			"EngineVersion":               "16.4",
+			"Port":                        5432,
+			"EnableCloudwatchLogsExports": []interface{}{"postgresql"},
+		})
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBClusterParameterGroup"), map[string]interface{}{
+			"Family": "aurora-postgresql16",
+			"Parameters": map[string]interface{}{
+				"timezone": "Asia/Tokyo",
+			},
+		})
+		template.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroupIngress"), map[string]interface{}{
+			"GroupId":               "sg-test-rds-staging",
+			"SourceSecurityGroupId": "sg-test-ecs-staging",
+			"FromPort":              5432,
+			"ToPort":                5432,
+			"Description":           "Allow PostgreSQL traffic from ECS",
+		})
+
+		// 出力の説明もエンジンに追従
+		template.HasOutput(jsii.String("AuroraClusterEndpoint"), map[string]interface{}{
+			"Description": "Aurora PostgreSQL Cluster Writer Endpoint",
+		})
+		template.HasOutput(jsii.String("AuroraReaderEndpoint"), map[string]interface{}{
+			"Description": "Aurora PostgreSQL Cluster Reader Endpoint",
+		})
+	})
+
+	t.Run("Aurora MySQL 3", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("prod")
+
+		// When: Aurora MySQL 3系を指定してStorageStackを作成
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment:           "prod",
+			VpcId:                 "vpc-12345",
+			TestEnvFlag:           true,
+			DatabaseEngine:        config.DatabaseEngineAuroraMySQL,
+			DatabaseEngineVersion: "3.08.0",
+		})
+
+		// Then
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBCluster"), map[string]interface{}{
+			"Port":                        3306,
+			"EnableCloudwatchLogsExports": []interface{}{"audit", "error", "general", "slowquery"},
+		})
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBClusterParameterGroup"), map[string]interface{}{
+			"Family": "aurora-mysql8.0",
+		})
+	})
+
+	t.Run("Major Version Upgrade", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("staging")
+
+		// When: 環境設定（2系で構築済みのステージング環境を3系にアップグレード）でStorageStackを作成
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment: "staging",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+		})
+
+		// Then: メジャーバージョンアップグレードを明示的に許可し、移行手順を出力
+		assert.True(t, config.GetDatabaseEngineConfig("staging").AllowMajorVersionUpgrade)
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBCluster"), map[string]interface{}{
+			"EngineVersion":            "8.0.mysql_aurora.3.08.0",
+			"AllowMajorVersionUpgrade": true,
+		})
+		template.HasOutput(jsii.String("AuroraMajorVersionUpgradeRunbook"), map[string]interface{}{
+			"Value": assertions.Match_StringLikeRegexp(jsii.String("create-db-cluster-snapshot --db-cluster-identifier service-staging-aurora-cluster-cmk --db-cluster-snapshot-identifier service-staging-aurora-cluster-cmk-before-3-08-0")),
+		})
+	})
+
+	t.Run("Production Stays on Aurora MySQL 2 Until Staging Is Verified", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("prod")
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment: "prod",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+		})
+
+		// Then: 本番環境（Global Database）はインプレースアップグレードを許可しない
+		assert.False(t, config.GetDatabaseEngineConfig("prod").AllowMajorVersionUpgrade)
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBCluster"), map[string]interface{}{
+			"EngineVersion":            "5.7.mysql_aurora.2.11.4",
+			"AllowMajorVersionUpgrade": assertions.Match_Absent(),
+		})
+		template.ResourceCountIs(jsii.String("AWS::RDS::GlobalCluster"), jsii.Number(1))
+		outputs := template.FindOutputs(jsii.String("AuroraMajorVersionUpgradeRunbook"), nil)
+		assert.Empty(t, *outputs)
+	})
+
+	t.Run("Stay on Aurora MySQL 2", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("prod")
+
+		// When: 延長サポート対象の2系を指定
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment:           "prod",
+			VpcId:                 "vpc-12345",
+			TestEnvFlag:           true,
+			DatabaseEngine:        config.DatabaseEngineAuroraMySQL,
+			DatabaseEngineVersion: "2.12.4",
+		})
+
+		// Then: MySQL 5.7互換のバージョン・パラメータグループファミリー・照合順序
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBCluster"), map[string]interface{}{
+			"Engine":        "aurora-mysql",
+			"EngineVersion": "5.7.mysql_aurora.2.12.4",
+		})
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBClusterParameterGroup"), map[string]interface{}{
+			"Family": "aurora-mysql5.7",
+			"Parameters": assertions.Match_ObjectLike(&map[string]interface{}{
+				"collation_server": "utf8mb4_general_ci",
+			}),
+		})
+	})
+
+	// サポート外のバージョン・インスタンスクラスの組み合わせは検証エラー
+	invalidCases := []struct {
+		name     string
+		dbConfig *config.DatabaseConfig
+		expected string
+	}{
+		{
+			name: "End-of-support Aurora MySQL 2 minor",
+			dbConfig: newTestDatabaseConfig(config.DatabaseEngineAuroraMySQL, "2.07.2",
+				config.DatabaseCapacityConfig{Mode: config.DatabaseCapacityProvisioned, InstanceCount: 1, InstanceClass: "r5.large"}),
+			expected: "unsupported Aurora MySQL version",
+		},
+		{
+			name: "Serverless v2 on Aurora MySQL 2",
+			dbConfig: newTestDatabaseConfig(config.DatabaseEngineAuroraMySQL, "2.12.4",
+				config.DatabaseCapacityConfig{Mode: config.DatabaseCapacityServerless, InstanceCount: 1, MinACU: 0.5, MaxACU: 2}),
+			expected: "Serverless v2 requires Aurora MySQL 3.02.0",
+		},
+		{
+			name: "Instance class newer than engine version",
+			dbConfig: newTestDatabaseConfig(config.DatabaseEngineAuroraMySQL, "3.04.0",
+				config.DatabaseCapacityConfig{Mode: config.DatabaseCapacityProvisioned, InstanceCount: 1, InstanceClass: "r8g.large"}),
+			expected: "instance class r8g.large is not supported by aurora-mysql 3.04.0",
+		},
+		{
+			name: "Instance class not available on major version",
+			dbConfig: newTestDatabaseConfig(config.DatabaseEngineAuroraPostgreSQL, "13.12",
+				config.DatabaseCapacityConfig{Mode: config.DatabaseCapacityProvisioned, InstanceCount: 1, InstanceClass: "r6gd.large"}),
+			expected: "instance class r6gd.large is not supported by aurora-postgresql 13.12",
+		},
+		{
+			name: "Burstable class smaller than medium",
+			dbConfig: newTestDatabaseConfig(config.DatabaseEngineAuroraMySQL, "3.08.0",
+				config.DatabaseCapacityConfig{Mode: config.DatabaseCapacityProvisioned, InstanceCount: 1, InstanceClass: "t3.small"}),
+			expected: "burstable classes require medium or larger",
+		},
+		{
+			name: "Serverless v2 on old PostgreSQL minor",
+			dbConfig: newTestDatabaseConfig(config.DatabaseEngineAuroraPostgreSQL, "13.4",
+				config.DatabaseCapacityConfig{Mode: config.DatabaseCapacityServerless, InstanceCount: 1, MinACU: 0.5, MaxACU: 2}),
+			expected: "Serverless v2 requires Aurora PostgreSQL 13.6",
+		},
+		{
+			name: "Major version upgrade on Global Database member",
+			dbConfig: func() *config.DatabaseConfig {
+				dbConfig := newTestDatabaseConfig(config.DatabaseEngineAuroraMySQL, "3.08.0",
+					config.DatabaseCapacityConfig{Mode: config.DatabaseCapacityProvisioned, InstanceCount: 1, InstanceClass: "r5.large"})
+				dbConfig.AllowMajorVersionUpgrade = true
+				dbConfig.Global = config.GetDatabaseGlobalConfig("prod")
+				return dbConfig
+			}(),
+			expected: "major version upgrade is not supported for Aurora Global Database members",
+		},
+		{
+			name: "Unknown engine",
+			dbConfig: newTestDatabaseConfig("oracle-ee", "19.0",
+				config.DatabaseCapacityConfig{Mode: config.DatabaseCapacityProvisioned, InstanceCount: 1, InstanceClass: "r5.large"}),
+			expected: "unsupported database engine",
+		},
+	}
+	for _, tc := range invalidCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.ErrorContains(t, config.ValidateDatabaseConfig(tc.dbConfig), tc.expected)
+		})
+	}
+
+	// StorageStackでは不正な設定はpanic
+	assert.Panics(t, func() {
+		app := CreateTestAppForStorageStack("dev")
+		stacks.NewStorageStack(app, "InvalidStorageStack", &stacks.StorageStackProps{
+			Environment:           "dev",
+			VpcId:                 "vpc-12345",
+			TestEnvFlag:           true,
+			DatabaseEngine:        config.DatabaseEngineAuroraMySQL,
+			DatabaseEngineVersion: "2.07.2",
+		})
+	}, "Should panic with unsupported engine version")
+
+	// 対応最小バージョン以降のインスタンスクラスは利用可能
+	assert.NoError(t, config.ValidateDatabaseConfig(newTestDatabaseConfig(config.DatabaseEngineAuroraMySQL, "3.08.0",
+		config.DatabaseCapacityConfig{Mode: config.DatabaseCapacityProvisioned, InstanceCount: 1, InstanceClass: "r8g.large"})))
+	assert.NoError(t, config.ValidateDatabaseConfig(newTestDatabaseConfig(config.DatabaseEngineAuroraMySQL, "2.12.4",
+		config.DatabaseCapacityConfig{Mode: config.DatabaseCapacityProvisioned, InstanceCount: 1, InstanceClass: "r7g.large"})))
+}
+
+// TestStorageStack_DatabaseCredentials DB認証情報の一元管理とローテーションのテスト
+func TestStorageStack_DatabaseCredentials(t *testing.T) {
+	testCases := []struct {
+		name              string
+		environment       string
+		envName           string
+		expectedRotations int
+		expectedSecrets   int // DB 2件 + Redis（AUTHトークン: 1件、ユーザーグループ: 2件）
+	}{
+		{name: "Development - No Rotation", environment: "dev", envName: "development", expectedRotations: 0, expectedSecrets: 3},
+		{name: "Staging - Rotation Enabled", environment: "staging", envName: "staging", expectedRotations: 2, expectedSecrets: 4},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			app := CreateTestAppForStorageStack(tc.environment)
+
+			// When
+			stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+				Environment: tc.environment,
+				VpcId:       "vpc-12345",
+				TestEnvFlag: true,
+			})
+
+			// Then: マスターユーザーとアプリケーションユーザーのシークレットをStorageStackで管理
+			template := assertions.Template_FromStack(stack, nil)
+			template.ResourceCountIs(jsii.String("AWS::SecretsManager::Secret"), jsii.Number(tc.expectedSecrets))
+			template.HasResourceProperties(jsii.String("AWS::SecretsManager::Secret"), map[string]interface{}{
+				"Name": "service-" + tc.envName + "-db-admin",
+				"GenerateSecretString": assertions.Match_ObjectLike(&map[string]interface{}{
+					"SecretStringTemplate": `{"username":"admin"}`,
+				}),
+			})
+			template.HasResourceProperties(jsii.String("AWS::SecretsManager::Secret"), map[string]interface{}{
+				"Name": "service-" + tc.envName + "-db-credentials",
+				"GenerateSecretString": assertions.Match_ObjectLike(&map[string]interface{}{
+					"SecretStringTemplate": assertions.Match_AnyValue(),
+				}),
+			})
+
+			// 両方のシークレットがクラスターに関連付けられる
+			template.ResourceCountIs(jsii.String("AWS::SecretsManager::SecretTargetAttachment"), jsii.Number(2))
+
+			// ローテーションは環境設定に従う（マスター: シングルユーザー、アプリ: マルチユーザー）
+			template.ResourceCountIs(jsii.String("AWS::SecretsManager::RotationSchedule"), jsii.Number(tc.expectedRotations))
+			if tc.expectedRotations > 0 {
+				template.HasResourceProperties(jsii.String("AWS::SecretsManager::RotationSchedule"), map[string]interface{}{
+					"RotationRules": map[string]interface{}{
+						"ScheduleExpression": "rate(30 days)",
+					},
+				})
+			}
+
+			// ApplicationStack向けにアプリケーションユーザーのシークレットARNを出力
+			template.HasOutput(jsii.String("DatabaseSecretArn"), map[string]interface{}{
+				"Export": map[string]interface{}{
+					"Name": "service-" + tc.envName + "-DB-Secret-Arn",
+				},
+			})
+
+			assert.NotNil(t, stack)
+		})
+	}
+}
+
+// TestStorageStack_DatabaseAppUser アプリケーションユーザーをデプロイ時に作成するタスクのテスト
+func TestStorageStack_DatabaseAppUser(t *testing.T) {
+	t.Run("Aurora MySQL", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("staging")
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment: "staging",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+		})
+
+		// Then: マスターユーザーの認証情報でユーザーを作成し、設定の権限のみを付与
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::ECS::TaskDefinition"), map[string]interface{}{
+			"Family": "service-staging-database-user",
+			"ContainerDefinitions": []interface{}{
+				assertions.Match_ObjectLike(&map[string]interface{}{
+					"Image": "mysql:8.0",
+					"Command": []interface{}{
+						"sh", "-c",
+						assertions.Match_StringLikeRegexp(jsii.String(`CREATE USER IF NOT EXISTS '\$DB_USERNAME'@'%'[\s\S]*GRANT SELECT, INSERT, UPDATE, DELETE ON \$DB_DATABASE\.\*`)),
+					},
+					"Secrets": assertions.Match_ArrayWith(&[]interface{}{
+						assertions.Match_ObjectLike(&map[string]interface{}{"Name": "DB_ADMIN_PASSWORD"}),
+						assertions.Match_ObjectLike(&map[string]interface{}{"Name": "DB_PASSWORD"}),
+					}),
+				}),
+			},
+		})
+
+		// タスクの終了を待機（終了コードが0以外の場合はデプロイ失敗）
+		template.ResourceCountIs(jsii.String("Custom::OneShotTask"), jsii.Number(1))
+		template.HasResource(jsii.String("Custom::OneShotTask"), map[string]interface{}{
+			"Properties": map[string]interface{}{
+				"Trigger": assertions.Match_AnyValue(),
+			},
+			"DependsOn": assertions.Match_ArrayWith(&[]interface{}{
+				assertions.Match_StringLikeRegexp(jsii.String("AuroraAppSecretAttachment")),
+				assertions.Match_StringLikeRegexp(jsii.String("AuroraClusterwriter")),
+			}),
+		})
+		template.ResourceCountIs(jsii.String("AWS::StepFunctions::StateMachine"), jsii.Number(1))
+
+		// マスターユーザーはRDS Proxyに登録しないため、タスクからクラスターへ直接接続
+		template.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroupIngress"), map[string]interface{}{
+			"Description": "Allow database traffic from user provisioning task",
+			"GroupId":     "sg-test-rds-staging",
+			"FromPort":    3306,
+		})
+	})
+
+	t.Run("Aurora PostgreSQL", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("staging")
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment:           "staging",
+			VpcId:                 "vpc-12345",
+			TestEnvFlag:           true,
+			DatabaseEngine:        config.DatabaseEngineAuroraPostgreSQL,
+			DatabaseEngineVersion: "16.4",
+		})
+
+		// Then: ロールを作成し、今後作成されるテーブルにも権限を付与
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::ECS::TaskDefinition"), map[string]interface{}{
+			"Family": "service-staging-database-user",
+			"ContainerDefinitions": []interface{}{
+				assertions.Match_ObjectLike(&map[string]interface{}{
+					"Image": "postgres:16-alpine",
+					"Command": []interface{}{
+						"sh", "-c",
+						assertions.Match_StringLikeRegexp(jsii.String(`CREATE ROLE[\s\S]*ALTER DEFAULT PRIVILEGES FOR ROLE "\$DB_ADMIN_USERNAME"`)),
+					},
+				}),
+			},
+		})
+	})
+
+	t.Run("Invalid Privileges", func(t *testing.T) {
+		// DDL・管理権限はアプリケーションユーザーに付与しない
+		dbConfig := config.GetDatabaseConfig("staging")
+		dbConfig.Credentials.AppPrivileges = []string{"SELECT", "DROP"}
+		assert.Error(t, config.ValidateDatabaseConfig(dbConfig))
+
+		dbConfig.Credentials.AppPrivileges = nil
+		assert.Error(t, config.ValidateDatabaseConfig(dbConfig))
+
+		// SQLに埋め込むユーザー名は英小文字・数字・アンダースコアのみ
+		dbConfig = config.GetDatabaseConfig("staging")
+		dbConfig.Credentials.AppUsername = "app'; DROP"
+		assert.Error(t, config.ValidateDatabaseConfig(dbConfig))
+	})
+}
+
+// TestStorageStack_DatabaseProxy RDS Proxyの作成・接続経路のテスト
+func TestStorageStack_DatabaseProxy(t *testing.T) {
+	t.Run("Staging - Proxy Enabled", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("staging")
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment: "staging",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+		})
+
+		// Then: アプリケーションユーザーのシークレットで認証するProxy
+		template := assertions.Template_FromStack(stack, nil)
+		template.ResourceCountIs(jsii.String("AWS::RDS::DBProxy"), jsii.Number(1))
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBProxy"), map[string]interface{}{
+			"DBProxyName":  "service-staging-db-proxy",
+			"EngineFamily": "MYSQL",
+			"RequireTLS":   true,
+			"Auth": []interface{}{
+				assertions.Match_ObjectLike(&map[string]interface{}{
+					"AuthScheme": "SECRETS",
+					"IAMAuth":    "DISABLED",
+					"SecretArn":  map[string]interface{}{"Ref": assertions.Match_StringLikeRegexp(jsii.String("AuroraAppSecretAttachment"))},
+				}),
+			},
+		})
+
+		// 接続プール設定
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBProxyTargetGroup"), map[string]interface{}{
+			"ConnectionPoolConfigurationInfo": map[string]interface{}{
+				"MaxConnectionsPercent":     90,
+				"MaxIdleConnectionsPercent": 50,
+				"ConnectionBorrowTimeout":   120,
+			},
+		})
+
+		// ECS → Proxy → Auroraの経路を許可（マイグレーション用にECSからクラスターへの直接接続も許可）
+		template.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroup"), map[string]interface{}{
+			"GroupName": "Service-staging-DBProxy-SG",
+		})
+		template.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroupIngress"), map[string]interface{}{
+			"GroupId":               map[string]interface{}{"Fn::GetAtt": []interface{}{assertions.Match_StringLikeRegexp(jsii.String("DatabaseProxySecurityGroup")), "GroupId"}},
+			"SourceSecurityGroupId": "sg-test-ecs-staging",
+			"FromPort":              3306,
+		})
+		template.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroupIngress"), map[string]interface{}{
+			"GroupId":               "sg-test-rds-staging",
+			"SourceSecurityGroupId": map[string]interface{}{"Fn::GetAtt": []interface{}{assertions.Match_StringLikeRegexp(jsii.String("DatabaseProxySecurityGroup")), "GroupId"}},
+			"FromPort":              3306,
+		})
+		template.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroupIngress"), map[string]interface{}{
+			"GroupId":               "sg-test-rds-staging",
+			"SourceSecurityGroupId": "sg-test-ecs-staging",
+			"FromPort":              3306,
+		})
+
+		// ApplicationStack向けにProxyのエンドポイント・ARNを出力
+		template.HasOutput(jsii.String("DatabaseProxyEndpoint"), map[string]interface{}{
+			"Export": map[string]interface{}{"Name": "service-staging-DB-Proxy-Endpoint"},
+		})
+		template.HasOutput(jsii.String("DatabaseProxyArn"), map[string]interface{}{
+			"Export": map[string]interface{}{"Name": "service-staging-DB-Proxy-Arn"},
+		})
+	})
+
+	t.Run("Development - Proxy Disabled", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("dev")
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment: "dev",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+		})
+
+		// Then: ECSタスクはクラスターに直接接続（許可はStorageStackでConnections経由で作成）
+		template := assertions.Template_FromStack(stack, nil)
+		template.ResourceCountIs(jsii.String("AWS::RDS::DBProxy"), jsii.Number(0))
+		template.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroupIngress"), map[string]interface{}{
+			"GroupId":               "sg-test-rds-dev",
+			"SourceSecurityGroupId": "sg-test-ecs-dev",
+			"FromPort":              3306,
+		})
+		outputs := template.FindOutputs(jsii.String("DatabaseProxyEndpoint"), nil)
+		assert.Empty(t, *outputs)
+	})
+
+	t.Run("IAM Authentication", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("prod")
+		proxyConfig := config.GetDatabaseProxyConfig("prod")
+		proxyConfig.IAMAuth = true
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment:   "prod",
+			VpcId:         "vpc-12345",
+			TestEnvFlag:   true,
+			DatabaseProxy: &proxyConfig,
+		})
+
+		// Then
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBProxy"), map[string]interface{}{
+			"RequireTLS": true,
+			"Auth": []interface{}{
+				assertions.Match_ObjectLike(&map[string]interface{}{"IAMAuth": "REQUIRED"}),
+			},
+		})
+	})
+
+	t.Run("IAM Authentication without TLS", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("prod")
+		proxyConfig := config.GetDatabaseProxyConfig("prod")
+		proxyConfig.IAMAuth = true
+		proxyConfig.RequireTLS = false
+
+		// When & Then: 不正な組み合わせはパニック
+		assert.Panics(t, func() {
+			stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+				Environment:   "prod",
+				VpcId:         "vpc-12345",
+				TestEnvFlag:   true,
+				DatabaseProxy: &proxyConfig,
+			})
+		})
+	})
+}
+
+// TestStorageStack_DatabaseParameters 環境別のパラメータグループのテスト
+func TestStorageStack_DatabaseParameters(t *testing.T) {
+	t.Run("Production - Cluster and Instance Parameter Groups", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("prod")
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment: "prod",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+		})
+
+		// Then: エンジン既定値（本番環境はAurora MySQL 2系）に環境別の値を上書き
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBClusterParameterGroup"), map[string]interface{}{
+			"Family": "aurora-mysql5.7",
+			"Parameters": map[string]interface{}{
+				"time_zone":                "Asia/Tokyo",
+				"character_set_server":     "utf8mb4",
+				"collation_server":         "utf8mb4_general_ci",
+				"require_secure_transport": "ON",
+			},
+		})
+		template.ResourceCountIs(jsii.String("AWS::RDS::DBParameterGroup"), jsii.Number(1))
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBParameterGroup"), map[string]interface{}{
+			"Family": "aurora-mysql5.7",
+			"Parameters": map[string]interface{}{
+				"slow_query_log":  "1",
+				"long_query_time": "2",
+				"max_connections": "1000",
+			},
+		})
+
+		// Writer・Readerすべてにインスタンスパラメータグループを適用
+		template.AllResourcesProperties(jsii.String("AWS::RDS::DBInstance"), map[string]interface{}{
+			"DBParameterGroupName": map[string]interface{}{"Ref": assertions.Match_StringLikeRegexp(jsii.String("AuroraInstanceParameterGroup"))},
+		})
 	})
 
-	// Then: S3バケットの確認（静的アセット、ログ、バックアップ用）
-	template := assertions.Template_FromStack(stack, nil)
-	template.ResourceCountIs(jsii.String("AWS::S3::Bucket"), jsii.Number(3))
+	t.Run("Aurora PostgreSQL - Engine Specific Parameters", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("staging")
 
-	// 静的アセット用バケット確認
-	template.HasResourceProperties(jsii.String("AWS::S3::Bucket"), map[string]interface{}{
-		"BucketName": "service-development-static-assets",
-		"PublicAccessBlockConfiguration": map[string]interface{}{
-			"BlockPublicAcls":       true,
-			"BlockPublicPolicy":     true,
-			"IgnorePublicAcls":      true,
-			"RestrictPublicBuckets": true,
-		},
+		// When: エンジンを変更すると環境別のパラメータもエンジンに合わせて選択
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment:           "staging",
+			VpcId:                 "vpc-12345",
+			TestEnvFlag:           true,
+			DatabaseEngine:        config.DatabaseEngineAuroraPostgreSQL,
+			DatabaseEngineVersion: "16.4",
+		})
+
+		// Then
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBParameterGroup"), map[string]interface{}{
+			"Family": "aurora-postgresql16",
+			"Parameters": map[string]interface{}{
+				"log_min_duration_statement": "1000",
+			},
+		})
 	})
 
-	assert.NotNil(t, stack)
-}
+	t.Run("Invalid Parameters", func(t *testing.T) {
+		testCases := []struct {
+			name       string
+			parameters config.DatabaseParametersConfig
+		}{
+			{name: "Other Engine Parameter", parameters: config.DatabaseParametersConfig{Cluster: map[string]string{"timezone": "Asia/Tokyo"}}},
+			{name: "Instance Parameter in Cluster Group", parameters: config.DatabaseParametersConfig{Cluster: map[string]string{"max_connections": "500"}}},
+			{name: "Empty Value", parameters: config.DatabaseParametersConfig{Instance: map[string]string{"long_query_time": ""}}},
+		}
 
-func TestStorageStack_CrossStackExports(t *testing.T) {
-	// Given
-	app := helpers.CreateTestApp(&helpers.TestAppConfig{
-		Environment: "dev",
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				// Given
+				app := CreateTestAppForStorageStack("dev")
+
+				// When & Then: エンジンファミリー・適用範囲に合わないパラメータはパニック
+				assert.Panics(t, func() {
+					stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+						Environment:        "dev",
+						VpcId:              "vpc-12345",
+						TestEnvFlag:        true,
+						DatabaseParameters: &tc.parameters,
+					})
+				})
+			})
+		}
 	})
 
-	// When: StorageStackを作成
-	stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
-		Environment: "dev",
-		VpcId:       "vpc-12345",
+	t.Run("Diff Between Environments", func(t *testing.T) {
+		// When
+		diffs := config.DiffEnvironmentDatabaseParameters("staging", "prod")
+
+		// Then: 実効値が異なるパラメータのみ（適用範囲・名前順、照合順序は本番環境が2系のため異なる）
+		assert.Equal(t, []config.DatabaseParameterDiff{
+			{Scope: config.ParameterScopeCluster, Name: "collation_server", Base: "utf8mb4_0900_ai_ci", Target: "utf8mb4_general_ci"},
+			{Scope: config.ParameterScopeCluster, Name: "require_secure_transport", Base: "", Target: "ON"},
+			{Scope: config.ParameterScopeCluster, Name: "server_audit_events", Base: "", Target: "CONNECT,QUERY_DCL,QUERY_DDL"},
+			{Scope: config.ParameterScopeCluster, Name: "server_audit_logging", Base: "", Target: "1"},
+			{Scope: config.ParameterScopeInstance, Name: "long_query_time", Base: "1", Target: "2"},
+			{Scope: config.ParameterScopeInstance, Name: "max_connections", Base: "", Target: "1000"},
+		}, diffs)
+
+		markdown := config.RenderDatabaseParameterDiffMarkdown("staging", "prod", diffs)
+		assert.Contains(t, markdown, "| Scope | Parameter | staging | prod |")
+		assert.Contains(t, markdown, "| cluster | require_secure_transport | (default) | ON |")
+		assert.Contains(t, markdown, "| instance | long_query_time | 1 | 2 |")
+
+		// 同一環境では差分なし
+		assert.Empty(t, config.DiffEnvironmentDatabaseParameters("prod", "prod"))
 	})
+}
 
-	// Then: Cross-stack出力の確認
-	template := assertions.Template_FromStack(stack, nil)
+// TestStorageStack_DatabaseMonitoring 拡張モニタリング・Performance Insights・監査ログのテスト
+func TestStorageStack_DatabaseMonitoring(t *testing.T) {
+	t.Run("Production - Full Observability", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("prod")
 
-	// Aurora Endpoint出力
-	template.HasOutput(jsii.String("AuroraClusterEndpoint"), map[string]interface{}{
-		"Description": "Aurora MySQL Cluster Writer Endpoint",
-		"Export": map[string]interface{}{
-			"Name": "service-development-Aurora-Endpoint",
-		},
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment: "prod",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+		})
+
+		// Then: 拡張モニタリングは専用ロールを使用
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::IAM::Role"), map[string]interface{}{
+			"AssumeRolePolicyDocument": map[string]interface{}{
+				"Statement": assertions.Match_ArrayWith(&[]interface{}{
+					assertions.Match_ObjectLike(&map[string]interface{}{
+						"Principal": map[string]interface{}{"Service": "monitoring.rds.amazonaws.com"},
+					}),
+				}),
+			},
+			"ManagedPolicyArns": assertions.Match_ArrayWith(&[]interface{}{
+				assertions.Match_ObjectLike(&map[string]interface{}{
+					"Fn::Join": assertions.Match_ArrayWith(&[]interface{}{
+						assertions.Match_ArrayWith(&[]interface{}{":iam::aws:policy/service-role/AmazonRDSEnhancedMonitoringRole"}),
+					}),
+				}),
+			}),
+		})
+		template.AllResourcesProperties(jsii.String("AWS::RDS::DBInstance"), map[string]interface{}{
+			"MonitoringInterval": 30,
+			"MonitoringRoleArn":  map[string]interface{}{"Fn::GetAtt": []interface{}{assertions.Match_StringLikeRegexp(jsii.String("AuroraMonitoringRole")), "Arn"}},
+		})
+
+		// Performance Insights（データベースのカスタマー管理キーで暗号化）
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBCluster"), map[string]interface{}{
+			"PerformanceInsightsEnabled":         true,
+			"PerformanceInsightsRetentionPeriod": 93,
+			"PerformanceInsightsKmsKeyId":        "arn:aws:kms:ap-northeast-1:123456789012:key/test-database-prod",
+		})
+
+		// Advanced Auditing（パラメータグループ + auditログのエクスポート）
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBClusterParameterGroup"), map[string]interface{}{
+			"Parameters": assertions.Match_ObjectLike(&map[string]interface{}{
+				"server_audit_logging": "1",
+				"server_audit_events":  "CONNECT,QUERY_DCL,QUERY_DDL",
+			}),
+		})
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBCluster"), map[string]interface{}{
+			"EnableCloudwatchLogsExports": assertions.Match_ArrayWith(&[]interface{}{"audit"}),
+		})
+
+		// エクスポートしたログの保持期間
+		template.ResourcePropertiesCountIs(jsii.String("Custom::LogRetention"), map[string]interface{}{
+			"RetentionInDays": 365,
+		}, jsii.Number(4))
 	})
 
-	// ElastiCache Endpoint出力
-	template.HasOutput(jsii.String("ElastiCacheEndpoint"), map[string]interface{}{
-		"Description": "ElastiCache Redis Primary Endpoint",
-		"Export": map[string]interface{}{
-			"Name": "service-development-Redis-Endpoint",
-		},
+	t.Run("Development - Minimal Monitoring", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("dev")
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment: "dev",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+		})
+
+		// Then: Performance Insights・監査ログなし
+		template := assertions.Template_FromStack(stack, nil)
+		template.AllResourcesProperties(jsii.String("AWS::RDS::DBInstance"), map[string]interface{}{
+			"MonitoringInterval": 60,
+		})
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBCluster"), map[string]interface{}{
+			"PerformanceInsightsEnabled": false,
+		})
+		template.ResourceCountIs(jsii.String("AWS::KMS::Key"), jsii.Number(0))
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBCluster"), map[string]interface{}{
+			"EnableCloudwatchLogsExports": []interface{}{"error", "general", "slowquery"},
+		})
+		template.ResourcePropertiesCountIs(jsii.String("Custom::LogRetention"), map[string]interface{}{
+			"RetentionInDays": 7,
+		}, jsii.Number(3))
 	})
 
-	// S3 Bucket名出力
-	template.HasOutput(jsii.String("StaticAssetsBucketName"), map[string]interface{}{
-		"Description": "Static Assets S3 Bucket Name",
-		"Export": map[string]interface{}{
-			"Name": "service-development-Static-Bucket",
-		},
+	t.Run("Aurora PostgreSQL - pgaudit", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("prod")
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment:           "prod",
+			VpcId:                 "vpc-12345",
+			TestEnvFlag:           true,
+			DatabaseEngine:        config.DatabaseEngineAuroraPostgreSQL,
+			DatabaseEngineVersion: "16.4",
+		})
+
+		// Then: 監査ログはpostgresqlログに出力
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBClusterParameterGroup"), map[string]interface{}{
+			"Parameters": assertions.Match_ObjectLike(&map[string]interface{}{
+				"shared_preload_libraries": "pgaudit",
+				"pgaudit.log":              "ddl,role",
+			}),
+		})
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBCluster"), map[string]interface{}{
+			"EnableCloudwatchLogsExports": []interface{}{"postgresql"},
+		})
 	})
 
-	assert.NotNil(t, stack)
+	t.Run("Invalid Performance Insights Retention", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("staging")
+		monitoring := config.GetDatabaseMonitoringConfig("staging")
+		monitoring.PerformanceInsightsRetentionDays = 30
+
+		// When & Then
+		assert.Panics(t, func() {
+			stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+				Environment:        "staging",
+				VpcId:              "vpc-12345",
+				TestEnvFlag:        true,
+				DatabaseMonitoring: &monitoring,
+			})
+		})
+	})
 }
 
-// TestStorageStack_SecuritySettings セキュリティ設定のテスト
-func TestStorageStack_SecuritySettings(t *testing.T) {
-	// Given
-	app := CreateTestAppForStorageStack("prod")
+// TestStorageStack_GlobalDatabase Aurora Global Database（DRリージョンのセカンダリクラスター）のテスト
+func TestStorageStack_GlobalDatabase(t *testing.T) {
+	t.Run("Primary Cluster", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("prod")
 
-	// When
-	stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
-		Environment: "prod",
-		VpcId:       "vpc-12345",
-		TestEnvFlag: true, // テスト環境フラグ
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment: "prod",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+		})
+
+		// Then: 既存クラスターをプライマリとしてGlobal Databaseを作成
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::RDS::GlobalCluster"), map[string]interface{}{
+			"GlobalClusterIdentifier":   "service-production-aurora-global",
+			"SourceDBClusterIdentifier": map[string]interface{}{"Ref": assertions.Match_StringLikeRegexp(jsii.String("AuroraCluster"))},
+			"DeletionProtection":        true,
+		})
+		template.HasOutput(jsii.String("AuroraGlobalClusterId"), map[string]interface{}{
+			"Export": map[string]interface{}{"Name": "service-production-Aurora-Global-Cluster-Id"},
+		})
+		template.HasOutput(jsii.String("AuroraGlobalFailoverRunbook"), map[string]interface{}{
+			"Value": map[string]interface{}{
+				"Fn::Sub": assertions.Match_StringLikeRegexp(jsii.String("failover-global-cluster --region ap-northeast-3 .*--allow-data-loss")),
+			},
+		})
 	})
 
-	// Then
-	template := assertions.Template_FromStack(stack, nil)
+	t.Run("Secondary Cluster", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("prod")
 
-	// Aurora暗号化設定確認
-	template.HasResourceProperties(jsii.String("AWS::RDS::DBCluster"), map[string]interface{}{
-		"StorageEncrypted":   true,
-		"DeletionProtection": true, // 本番環境では削除保護有効
-	})
+		// When: DRリージョン用のStackとして作成
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment:  "prod",
+			VpcId:        "vpc-12345",
+			TestEnvFlag:  true,
+			DatabaseRole: config.DatabaseRoleSecondary,
+		})
 
-	// ElastiCache暗号化設定確認
-	template.HasResourceProperties(jsii.String("AWS::ElastiCache::ReplicationGroup"), map[string]interface{}{
-		"AtRestEncryptionEnabled":  true,
-		"TransitEncryptionEnabled": true,
+		// Then: Global Databaseに参加するセカンダリクラスター（マスターユーザーは指定しない）
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBCluster"), map[string]interface{}{
+			"GlobalClusterIdentifier": "service-production-aurora-global",
+			"DBClusterIdentifier":     "service-production-aurora-secondary",
+			"EngineVersion":           "5.7.mysql_aurora.2.11.4",
+			"StorageEncrypted":        true,
+			"MasterUsername":          assertions.Match_Absent(),
+			"VpcSecurityGroupIds":     []interface{}{"sg-test-rds-prod"},
+		})
+		template.ResourceCountIs(jsii.String("AWS::RDS::DBInstance"), jsii.Number(1))
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBInstance"), map[string]interface{}{
+			"DBInstanceClass": "db.r5.large",
+		})
+
+		// 認証情報・Proxy・キャッシュ・S3はプライマリリージョンのみ
+		template.ResourceCountIs(jsii.String("AWS::RDS::GlobalCluster"), jsii.Number(0))
+		template.ResourceCountIs(jsii.String("AWS::SecretsManager::Secret"), jsii.Number(0))
+		template.ResourceCountIs(jsii.String("AWS::RDS::DBProxy"), jsii.Number(0))
+		template.ResourceCountIs(jsii.String("AWS::ElastiCache::ReplicationGroup"), jsii.Number(0))
+		template.ResourceCountIs(jsii.String("AWS::S3::Bucket"), jsii.Number(0))
+
+		// DRリージョンのエンドポイントと昇格手順を出力
+		template.HasOutput(jsii.String("AuroraSecondaryClusterEndpoint"), map[string]interface{}{
+			"Export": map[string]interface{}{"Name": "service-production-Aurora-Secondary-Endpoint"},
+		})
+		template.HasOutput(jsii.String("AuroraSecondaryReaderEndpoint"), map[string]interface{}{
+			"Export": map[string]interface{}{"Name": "service-production-Aurora-Secondary-Reader-Endpoint"},
+		})
+		template.HasOutput(jsii.String("AuroraGlobalFailoverRunbook"), map[string]interface{}{})
 	})
 
-	// S3バケット暗号化設定確認
-	template.HasResourceProperties(jsii.String("AWS::S3::Bucket"), map[string]interface{}{
-		"BucketEncryption": map[string]interface{}{
-			"ServerSideEncryptionConfiguration": assertions.Match_AnyValue(),
-		},
-		"PublicAccessBlockConfiguration": map[string]interface{}{
-			"BlockPublicAcls":       true,
-			"BlockPublicPolicy":     true,
-			"IgnorePublicAcls":      true,
-			"RestrictPublicBuckets": true,
-		},
+	t.Run("Headless Secondary Cluster", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("prod")
+		global := config.GetDatabaseGlobalConfig("prod")
+		global.SecondaryInstanceCount = 0
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment:    "prod",
+			VpcId:          "vpc-12345",
+			TestEnvFlag:    true,
+			DatabaseRole:   config.DatabaseRoleSecondary,
+			DatabaseGlobal: &global,
+		})
+
+		// Then: インスタンスなし、昇格前にインスタンスを追加する手順を出力
+		template := assertions.Template_FromStack(stack, nil)
+		template.ResourceCountIs(jsii.String("AWS::RDS::DBInstance"), jsii.Number(0))
+		template.HasOutput(jsii.String("AuroraGlobalFailoverRunbook"), map[string]interface{}{
+			"Value": map[string]interface{}{
+				"Fn::Sub": assertions.Match_StringLikeRegexp(jsii.String("Headless secondary")),
+			},
+		})
 	})
 
-	assert.NotNil(t, stack)
-}
+	t.Run("Disabled in Development", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("dev")
 
-// TestStorageStack_InstanceTypes Aurora環境別インスタンスタイプのテスト
-func TestStorageStack_InstanceTypes(t *testing.T) {
-	testCases := []struct {
-		name                 string
-		environment          string
-		expectedInstanceType string
-		expectedInstances    int
-	}{
-		{
-			name:                 "Development - Small Instance",
-			environment:          "dev",
-			expectedInstanceType: "db.t3.small",
-			expectedInstances:    1,
-		},
-		{
-			name:                 "Staging - Medium Instance",
-			environment:          "staging",
-			expectedInstanceType: "db.t3.medium",
-			expectedInstances:    2,
-		},
-		{
-			name:                 "Production - Large Instance",
-			environment:          "prod",
-			expectedInstanceType: "db.r5.large",
-			expectedInstances:    3,
-		},
-	}
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment: "dev",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+		})
 
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			// Given
-			app := CreateTestAppForStorageStack(tc.environment)
+		// Then
+		template := assertions.Template_FromStack(stack, nil)
+		template.ResourceCountIs(jsii.String("AWS::RDS::GlobalCluster"), jsii.Number(0))
 
-			// When
-			stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
-				Environment: tc.environment,
-				VpcId:       "vpc-12345",
-				TestEnvFlag: true, // テスト環境フラグ
+		// Global Database無効の環境ではセカンダリを作成できない
+		assert.Panics(t, func() {
+			stacks.NewStorageStack(CreateTestAppForStorageStack("dev"), "TestStorageStackDR", &stacks.StorageStackProps{
+				Environment:  "dev",
+				VpcId:        "vpc-12345",
+				TestEnvFlag:  true,
+				DatabaseRole: config.DatabaseRoleSecondary,
 			})
+		})
+	})
 
-			// Then: RDS設定の確認
-			template := assertions.Template_FromStack(stack, nil)
+	t.Run("Primary in Secondary Region", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("prod")
 
-			// Aurora Instance数確認
-			template.ResourceCountIs(jsii.String("AWS::RDS::DBInstance"), jsii.Number(tc.expectedInstances))
-			template.HasResourceProperties(jsii.String("AWS::RDS::DBInstance"), map[string]interface{}{
-				"DBInstanceClass": tc.expectedInstanceType,
+		// When & Then: プライマリをDRリージョンにデプロイしようとするとパニック
+		assert.Panics(t, func() {
+			stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+				StackProps: awscdk.StackProps{
+					Env: &awscdk.Environment{Account: jsii.String("123456789012"), Region: jsii.String("ap-northeast-3")},
+				},
+				Environment: "prod",
+				VpcId:       "vpc-12345",
+				TestEnvFlag: true,
 			})
+		})
+	})
+}
 
-			assert.NotNil(t, stack)
+// TestStorageStack_DatabaseSeed スナップショット復元・クローン・個人情報マスキングのテスト
+func TestStorageStack_DatabaseSeed(t *testing.T) {
+	t.Run("Restore From Snapshot", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("staging")
+		seed := newMaskedSeedConfig()
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment:  "staging",
+			VpcId:        "vpc-12345",
+			TestEnvFlag:  true,
+			DatabaseSeed: &seed,
 		})
-	}
+
+		// Then: スナップショットから復元（マスターユーザー名・データベース名は引き継ぐ）
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBCluster"), map[string]interface{}{
+			"SnapshotIdentifier":  "service-production-aurora-2026-10-01",
+			"MasterUsername":      assertions.Match_Absent(),
+			"DatabaseName":        assertions.Match_Absent(),
+			"MasterUserPassword":  assertions.Match_AnyValue(),
+			"DBClusterIdentifier": "service-staging-aurora-cluster-cmk",
+		})
+
+		// 復元後に個人情報マスキングタスクを一度だけ実行
+		template.HasResourceProperties(jsii.String("AWS::ECS::TaskDefinition"), map[string]interface{}{
+			"Family": "service-staging-data-masking",
+			"Cpu":    "256",
+			"Memory": "512",
+			"ContainerDefinitions": assertions.Match_ArrayWith(&[]interface{}{
+				assertions.Match_ObjectLike(&map[string]interface{}{
+					"Image":   "public.ecr.aws/docker/library/php:8.3-cli",
+					"Command": []interface{}{"php", "/app/artisan", "db:mask-pii", "--force"},
+					"Secrets": assertions.Match_ArrayWith(&[]interface{}{
+						assertions.Match_ObjectLike(&map[string]interface{}{"Name": "DB_ADMIN_PASSWORD"}),
+						assertions.Match_ObjectLike(&map[string]interface{}{"Name": "DB_PASSWORD"}),
+					}),
+				}),
+			}),
+		})
+		// タスクの停止（終了コード0）を待機し、クラスターの置換時は再実行
+		template.ResourceCountIs(jsii.String("Custom::AWS"), jsii.Number(0))
+		template.ResourceCountIs(jsii.String("Custom::OneShotTask"), jsii.Number(2))
+		template.HasResource(jsii.String("Custom::OneShotTask"), map[string]interface{}{
+			"Properties": map[string]interface{}{
+				"TaskDefinition": map[string]interface{}{
+					"Ref": assertions.Match_StringLikeRegexp(jsii.String("DataMaskingTaskDefinition")),
+				},
+				"Trigger": assertions.Match_AnyValue(),
+			},
+			"DependsOn": assertions.Match_ArrayWith(&[]interface{}{
+				assertions.Match_StringLikeRegexp(jsii.String("AuroraCluster")),
+			}),
+		})
+
+		// アプリケーションユーザーのパスワードはマスキングの成功後に設定
+		template.HasResource(jsii.String("Custom::OneShotTask"), map[string]interface{}{
+			"Properties": map[string]interface{}{
+				"TaskDefinition": map[string]interface{}{
+					"Ref": assertions.Match_StringLikeRegexp(jsii.String("DatabaseUserTaskDefinition")),
+				},
+			},
+			"DependsOn": assertions.Match_ArrayWith(&[]interface{}{
+				assertions.Match_StringLikeRegexp(jsii.String("RunDataMaskingTask")),
+			}),
+		})
+		template.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroupIngress"), map[string]interface{}{
+			"Description": "Allow database traffic from data masking task",
+			"GroupId":     "sg-test-rds-staging",
+		})
+		template.HasOutput(jsii.String("DataMaskingTaskDefinitionArn"), map[string]interface{}{})
+	})
+
+	t.Run("Copy-on-write Clone", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("staging")
+		seed := config.DatabaseSeedConfig{
+			Mode:                    config.DatabaseSeedClone,
+			SourceClusterIdentifier: "service-production-aurora",
+		}
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment:  "staging",
+			VpcId:        "vpc-12345",
+			TestEnvFlag:  true,
+			DatabaseSeed: &seed,
+		})
+
+		// Then: クローン元の最新時点からコピーオンライトで作成
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBCluster"), map[string]interface{}{
+			"RestoreType":               "copy-on-write",
+			"SourceDBClusterIdentifier": "service-production-aurora",
+			"UseLatestRestorableTime":   true,
+			"MasterUsername":            assertions.Match_Absent(),
+			"MasterUserPassword":        assertions.Match_AnyValue(),
+		})
+
+		// マスキング無効の場合はタスクを作成しない
+		assertNoDataMaskingTask(t, template)
+		template.ResourceCountIs(jsii.String("Custom::AWS"), jsii.Number(0))
+	})
+
+	t.Run("No Seed by Default", func(t *testing.T) {
+		// Given
+		app := CreateTestAppForStorageStack("staging")
+
+		// When
+		stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+			Environment: "staging",
+			VpcId:       "vpc-12345",
+			TestEnvFlag: true,
+		})
+
+		// Then: 新規作成（マスキングは復元・クローン時のみ）
+		template := assertions.Template_FromStack(stack, nil)
+		template.HasResourceProperties(jsii.String("AWS::RDS::DBCluster"), map[string]interface{}{
+			"SnapshotIdentifier": assertions.Match_Absent(),
+			"RestoreType":        assertions.Match_Absent(),
+			"MasterUsername":     assertions.Match_AnyValue(),
+		})
+		assertNoDataMaskingTask(t, template)
+	})
+
+	t.Run("Invalid Seed Config", func(t *testing.T) {
+		invalidSeeds := []config.DatabaseSeedConfig{
+			{Mode: config.DatabaseSeedSnapshot},
+			{Mode: config.DatabaseSeedClone},
+			{Mode: "pitr"},
+			{
+				Mode:                    config.DatabaseSeedClone,
+				SourceClusterIdentifier: "service-production-aurora",
+				Masking:                 config.DatabaseMaskingConfig{Enabled: true, CPU: 256, Memory: 512},
+			},
+			{
+				Mode:               config.DatabaseSeedSnapshot,
+				SnapshotIdentifier: "service-production-aurora-2026-10-01",
+				Masking:            config.DatabaseMaskingConfig{Enabled: true, Image: "php:8.3-cli", CPU: 256, Memory: 512, TimeoutMinutes: 61},
+			},
+		}
+
+		for _, seed := range invalidSeeds {
+			// When & Then: 不正な設定はパニック
+			assert.Panics(t, func() {
+				stacks.NewStorageStack(CreateTestAppForStorageStack("staging"), "TestStorageStack", &stacks.StorageStackProps{
+					Environment:  "staging",
+					VpcId:        "vpc-12345",
+					TestEnvFlag:  true,
+					DatabaseSeed: &seed,
+				})
+			}, "seed: %+v", seed)
+		}
+	})
 }
 
 // TestStorageStack_BackupConfiguration バックアップ設定のテスト
@@ -437,6 +2800,93 @@ func TestStorageStack_BackupConfiguration(t *testing.T) {
 	}
 }
 
+// TestStorageStack_SecurityGroupSegregation データベースとキャッシュのセキュリティグループ分離テスト
+func TestStorageStack_SecurityGroupSegregation(t *testing.T) {
+	// Given
+	app := CreateTestAppForStorageStack("staging")
+
+	// When: RDS Proxyを使用せずクラスターに直接接続（EFSのセキュリティグループは別のテストで確認）
+	stack := stacks.NewStorageStack(app, "TestStorageStack", &stacks.StorageStackProps{
+		Environment:   "staging",
+		VpcId:         "vpc-12345",
+		TestEnvFlag:   true,
+		DatabaseProxy: &config.DatabaseProxyConfig{Enabled: false},
+		FileSystem:    &config.FileSystemConfig{Enabled: false},
+	})
+
+	// Then: Auroraはデータベース用SG、Redisはキャッシュ用SGのみを使用
+	template := assertions.Template_FromStack(stack, nil)
+
+	template.HasResourceProperties(jsii.String("AWS::RDS::DBCluster"), map[string]interface{}{
+		"Port":                3306,
+		"VpcSecurityGroupIds": []interface{}{"sg-test-rds-staging"},
+	})
+
+	template.HasResourceProperties(jsii.String("AWS::ElastiCache::ReplicationGroup"), map[string]interface{}{
+		"Port":             6379,
+		"SecurityGroupIds": []interface{}{"sg-test-cache-staging"},
+	})
+
+	// StorageStack側で作成するセキュリティグループはシークレットローテーション用Lambdaとユーザー作成タスクのみ
+	template.ResourceCountIs(jsii.String("AWS::EC2::SecurityGroup"), jsii.Number(3))
+	template.AllResourcesProperties(jsii.String("AWS::EC2::SecurityGroup"), map[string]interface{}{
+		"GroupDescription": assertions.Match_StringLikeRegexp(jsii.String("Rotation|user provisioning")),
+	})
+
+	// ECSからの許可（エンジンのポート）+ ローテーション用Lambda・ユーザー作成タスクからの許可
+	template.ResourceCountIs(jsii.String("AWS::EC2::SecurityGroupIngress"), jsii.Number(5))
+	template.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroupIngress"), map[string]interface{}{
+		"GroupId":               "sg-test-rds-staging",
+		"SourceSecurityGroupId": "sg-test-ecs-staging",
+		"FromPort":              3306,
+		"ToPort":                3306,
+		"Description":           "Allow MySQL traffic from ECS",
+	})
+	template.HasResourceProperties(jsii.String("AWS::EC2::SecurityGroupIngress"), map[string]interface{}{
+		"GroupId":               "sg-test-cache-staging",
+		"SourceSecurityGroupId": "sg-test-ecs-staging",
+		"FromPort":              6379,
+		"ToPort":                6379,
+		"Description":           "Allow Redis traffic from ECS",
+	})
+
+	// Egress制限なしの環境ではECS側のEgressは作成しない
+	template.ResourceCountIs(jsii.String("AWS::EC2::SecurityGroupEgress"), jsii.Number(0))
+
+	assert.NotNil(t, stack)
+}
+
+// newTestDatabaseConfig 開発環境の設定からエンジン・容量を変更したAurora設定を作成
+func newTestDatabaseConfig(engine string, version string, capacity config.DatabaseCapacityConfig) *config.DatabaseConfig {
+	dbConfig := config.GetDatabaseConfig("dev")
+	dbConfig.SetEngine(engine, version)
+	dbConfig.Capacity = capacity
+	return dbConfig
+}
+
+// newMaskedSeedConfig 本番スナップショットから復元し、個人情報をマスキングする初期データ設定
+func newMaskedSeedConfig() config.DatabaseSeedConfig {
+	return config.DatabaseSeedConfig{
+		Mode:               config.DatabaseSeedSnapshot,
+		SnapshotIdentifier: "service-production-aurora-2026-10-01",
+		Masking: config.DatabaseMaskingConfig{
+			Enabled: true,
+			Image:   "public.ecr.aws/docker/library/php:8.3-cli",
+			Command: []string{"php", "/app/artisan", "db:mask-pii", "--force"},
+			CPU:     256,
+			Memory:  512,
+		},
+	}
+}
+
+// assertNoDataMaskingTask マスキングタスクのタスク定義が作成されていないことを確認
+func assertNoDataMaskingTask(t *testing.T, template assertions.Template) {
+	taskDefinitions := template.FindResources(jsii.String("AWS::ECS::TaskDefinition"), map[string]interface{}{
+		"Properties": map[string]interface{}{"Family": "service-staging-data-masking"},
+	})
+	assert.Empty(t, *taskDefinitions)
+}
+
 // TestStorageStack_ErrorHandling エラーハンドリングのテスト
 func TestStorageStack_ErrorHandling(t *testing.T) {
 	// Given